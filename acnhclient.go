@@ -0,0 +1,190 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// ACNHClient is the set of methods provided by Client, so that code using a
+// client can be given a test double instead, such as the one in the mock
+// package.
+type ACNHClient interface {
+	acnhClientIterators
+
+	ArtByID(id int) (*Art, error)
+	ArtByIDContext(ctx context.Context, id int) (*Art, error)
+	ArtByIDs(ids []int) ([]*Art, error)
+	ArtByIDsContext(ctx context.Context, ids []int) ([]*Art, error)
+	ArtByName(name string) (*Art, error)
+	ArtByNameContext(ctx context.Context, name string) (*Art, error)
+	ArtImageDownload(art *Art, downloadDirectory string) (string, error)
+	ArtImageDownloadContext(ctx context.Context, art *Art, downloadDirectory string) (string, error)
+	ArtImageDownloadTo(art *Art, w io.Writer) error
+	ArtImageDownloadToContext(ctx context.Context, art *Art, w io.Writer) error
+	ArtList() ([]*Art, error)
+	ArtListContext(ctx context.Context) ([]*Art, error)
+	AssetURL(uri string) string
+	BGMDownload(track *BGMTrack, downloadDirectory string) (string, error)
+	BGMDownloadContext(ctx context.Context, track *BGMTrack, downloadDirectory string) (string, error)
+	BGMDownloadTemp(track *BGMTrack) (string, error)
+	BGMDownloadTempContext(ctx context.Context, track *BGMTrack) (string, error)
+	BGMDownloadTo(track *BGMTrack, w io.Writer) error
+	BGMDownloadToContext(ctx context.Context, track *BGMTrack, w io.Writer) error
+	BGMList() ([]*BGMTrack, error)
+	BGMListByHour(hour int) ([]*BGMTrack, error)
+	BGMListByHourContext(ctx context.Context, hour int) ([]*BGMTrack, error)
+	BGMListByWeather(weather Weather) ([]*BGMTrack, error)
+	BGMListByWeatherContext(ctx context.Context, weather Weather) ([]*BGMTrack, error)
+	BGMListContext(ctx context.Context) ([]*BGMTrack, error)
+	BGMReport() (*BGMReport, error)
+	BGMReportContext(ctx context.Context) (*BGMReport, error)
+	BGMTrackByExternalID(translator IDTranslator, externalID string) (*BGMTrack, error)
+	BGMTrackByExternalIDContext(ctx context.Context, translator IDTranslator, externalID string) (*BGMTrack, error)
+	BGMTrackByID(id int) (*BGMTrack, error)
+	BGMTrackByIDContext(ctx context.Context, id int) (*BGMTrack, error)
+	BGMTrackByQuery(hour int, weather Weather) (*BGMTrack, error)
+	BGMTrackByQueryContext(ctx context.Context, hour int, weather Weather) (*BGMTrack, error)
+	BGMTracksByIDs(ids []int) ([]*BGMTrack, error)
+	BGMTracksByIDsContext(ctx context.Context, ids []int) ([]*BGMTrack, error)
+	BGMURL(track *BGMTrack) string
+	BugIconDownload(id int, downloadDirectory string) (string, error)
+	BugIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	BugImageDownload(id int, downloadDirectory string) (string, error)
+	BugImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	Capabilities() *Capabilities
+	CategoryJSON(ctx context.Context, category Category) ([]byte, error)
+	CheckIntegrity() (*IntegrityReport, error)
+	CheckIntegrityContext(ctx context.Context) (*IntegrityReport, error)
+	CoverageReport(ctx context.Context) (*CoverageReport, error)
+	CustomEntries(kinds ...Category) []*CustomEntry
+	CustomEntryByID(id int) (*CustomEntry, error)
+	CustomEntryByName(name string) (*CustomEntry, error)
+	DownloadAllBGM(downloadDirectory string) ([]*BGMDownloadResult, error)
+	DownloadAllBGMContext(ctx context.Context, downloadDirectory string) ([]*BGMDownloadResult, error)
+	DownloadAllSongs(downloadDirectory string) ([]*SongDownloadResult, error)
+	DownloadAllSongsContext(ctx context.Context, downloadDirectory string) ([]*SongDownloadResult, error)
+	FishByExternalID(translator IDTranslator, externalID string) (*Fish, error)
+	FishByExternalIDContext(ctx context.Context, translator IDTranslator, externalID string) (*Fish, error)
+	FishByID(id int) (*Fish, error)
+	FishByIDContext(ctx context.Context, id int) (*Fish, error)
+	FishByIDs(ids []int) ([]*Fish, error)
+	FishByIDsContext(ctx context.Context, ids []int) ([]*Fish, error)
+	FishByName(name string) (*Fish, error)
+	FishByNameContext(ctx context.Context, name string) (*Fish, error)
+	FishIconDownload(id int, downloadDirectory string) (string, error)
+	FishIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	FishImageDownload(id int, downloadDirectory string) (string, error)
+	FishImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	FishList() ([]*Fish, error)
+	FishListContext(ctx context.Context) ([]*Fish, error)
+	FossilByName(name string) (*Fossil, error)
+	FossilByNameContext(ctx context.Context, name string) (*Fossil, error)
+	FossilGroups() (map[string][]*Fossil, error)
+	FossilGroupsContext(ctx context.Context) (map[string][]*Fossil, error)
+	FossilImageDownload(fossil *Fossil, downloadDirectory string) (string, error)
+	FossilImageDownloadContext(ctx context.Context, fossil *Fossil, downloadDirectory string) (string, error)
+	FossilImageDownloadTo(fossil *Fossil, w io.Writer) error
+	FossilImageDownloadToContext(ctx context.Context, fossil *Fossil, w io.Writer) error
+	FossilList() ([]*Fossil, error)
+	FossilListContext(ctx context.Context) ([]*Fossil, error)
+	FossilsByGroup(group string) ([]*Fossil, error)
+	FossilsByGroupContext(ctx context.Context, group string) ([]*Fossil, error)
+	GetRaw(ctx context.Context, category Category, id int) (json.RawMessage, error)
+	Hemisphere() Hemisphere
+	HousewareByName(name string) (*HousewareItem, error)
+	HousewareByNameContext(ctx context.Context, name string) (*HousewareItem, error)
+	HousewareImagesDownload(item *HousewareItem, downloadDirectory string) ([]string, error)
+	HousewareImagesDownloadContext(ctx context.Context, item *HousewareItem, downloadDirectory string) ([]string, error)
+	HousewareList() ([]*HousewareItem, error)
+	HousewareListContext(ctx context.Context) ([]*HousewareItem, error)
+	IconDownload(category Category, id int, downloadDirectory string) (string, error)
+	IconDownloadContext(ctx context.Context, category Category, id int, downloadDirectory string) (string, error)
+	IconDownloadTo(category Category, id int, w io.Writer) error
+	IconDownloadToContext(ctx context.Context, category Category, id int, w io.Writer) error
+	IconURL(category Category, id int) string
+	ImageDownload(category Category, id int, downloadDirectory string) (string, error)
+	ImageDownloadContext(ctx context.Context, category Category, id int, downloadDirectory string) (string, error)
+	ImageDownloadTo(category Category, id int, w io.Writer) error
+	ImageDownloadToContext(ctx context.Context, category Category, id int, w io.Writer) error
+	ImageURL(category Category, id int) string
+	InvalidateCache()
+	InvalidateDiskCache() error
+	ListSince(ctx context.Context, category Category, etag string) (*ListResult, error)
+	LocalName(names map[string]string) string
+	MiscItemByName(name string) (*MiscItem, error)
+	MiscItemByNameContext(ctx context.Context, name string) (*MiscItem, error)
+	MiscItemImagesDownload(item *MiscItem, downloadDirectory string) ([]string, error)
+	MiscItemImagesDownloadContext(ctx context.Context, item *MiscItem, downloadDirectory string) ([]string, error)
+	MiscItemList() ([]*MiscItem, error)
+	MiscItemListContext(ctx context.Context) ([]*MiscItem, error)
+	PlanDownloadAllBGM(downloadDirectory string) (*DownloadPlan, error)
+	PlanDownloadAllBGMContext(ctx context.Context, downloadDirectory string) (*DownloadPlan, error)
+	PlanDownloadAllSongs(downloadDirectory string) (*DownloadPlan, error)
+	PlanDownloadAllSongsContext(ctx context.Context, downloadDirectory string) (*DownloadPlan, error)
+	RecipeByName(name string) (*Recipe, error)
+	RecipeByNameContext(ctx context.Context, name string) (*Recipe, error)
+	RecipeCraftedItem(recipe *Recipe) ([]*ItemVariant, error)
+	RecipeCraftedItemContext(ctx context.Context, recipe *Recipe) ([]*ItemVariant, error)
+	RecipeList() ([]*Recipe, error)
+	RecipeListContext(ctx context.Context) ([]*Recipe, error)
+	RegisterCustomEntry(entry *CustomEntry) error
+	RemoveCustomEntry(id int)
+	SeaCreatureByID(id int) (*SeaCreature, error)
+	SeaCreatureByIDContext(ctx context.Context, id int) (*SeaCreature, error)
+	SeaCreatureByName(name string) (*SeaCreature, error)
+	SeaCreatureByNameContext(ctx context.Context, name string) (*SeaCreature, error)
+	SeaCreatureIconDownload(id int, downloadDirectory string) (string, error)
+	SeaCreatureIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	SeaCreatureImageDownload(id int, downloadDirectory string) (string, error)
+	SeaCreatureImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	SeaCreatureList() ([]*SeaCreature, error)
+	SeaCreatureListContext(ctx context.Context) ([]*SeaCreature, error)
+	SeaCreaturesByIDs(ids []int) ([]*SeaCreature, error)
+	SeaCreaturesByIDsContext(ctx context.Context, ids []int) ([]*SeaCreature, error)
+	SnapshotVersion() (*SnapshotVersion, error)
+	SongByExternalID(translator IDTranslator, externalID string) (*Song, error)
+	SongByExternalIDContext(ctx context.Context, translator IDTranslator, externalID string) (*Song, error)
+	SongByID(id int) (*Song, error)
+	SongByIDContext(ctx context.Context, id int) (*Song, error)
+	SongByName(name string) (*Song, error)
+	SongByNameContext(ctx context.Context, name string) (*Song, error)
+	SongDownload(song *Song, downloadDirectory string) (string, error)
+	SongDownloadContext(ctx context.Context, song *Song, downloadDirectory string) (string, error)
+	SongDownloadTemp(song *Song) (string, error)
+	SongDownloadTempContext(ctx context.Context, song *Song) (string, error)
+	SongDownloadTo(song *Song, w io.Writer) error
+	SongDownloadToContext(ctx context.Context, song *Song, w io.Writer) error
+	SongList() ([]*Song, error)
+	SongListContext(ctx context.Context) ([]*Song, error)
+	SongURL(song *Song) string
+	SongsByIDs(ids []int) ([]*Song, error)
+	SongsByIDsContext(ctx context.Context, ids []int) ([]*Song, error)
+	TimeFormatter(clock24 bool) TimeFormatter
+	Use(middleware ...Middleware)
+	VariantByID(id int) (*ItemVariant, error)
+	VariantByIDContext(ctx context.Context, id int) (*ItemVariant, error)
+	VariantImageDownload(variant *ItemVariant, downloadDirectory string) (string, error)
+	VariantImageDownloadContext(ctx context.Context, variant *ItemVariant, downloadDirectory string) (string, error)
+	VariantImageDownloadTo(variant *ItemVariant, w io.Writer) error
+	VariantImageDownloadToContext(ctx context.Context, variant *ItemVariant, w io.Writer) error
+	VariantImagesDownload(itemName string, variants []*ItemVariant, downloadDirectory string) ([]string, error)
+	VariantImagesDownloadContext(ctx context.Context, itemName string, variants []*ItemVariant, downloadDirectory string) ([]string, error)
+	VillagerIconDownload(id int, downloadDirectory string) (string, error)
+	VillagerIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	VillagerIconDownloadTo(id int, w io.Writer) error
+	VillagerIconDownloadToContext(ctx context.Context, id int, w io.Writer) error
+	VillagerImageDownload(id int, downloadDirectory string) (string, error)
+	VillagerImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error)
+	VillagerImageDownloadTo(id int, w io.Writer) error
+	VillagerImageDownloadToContext(ctx context.Context, id int, w io.Writer) error
+	WallMountedByName(name string) (*WallMountedItem, error)
+	WallMountedByNameContext(ctx context.Context, name string) (*WallMountedItem, error)
+	WallMountedImagesDownload(item *WallMountedItem, downloadDirectory string) ([]string, error)
+	WallMountedImagesDownloadContext(ctx context.Context, item *WallMountedItem, downloadDirectory string) ([]string, error)
+	WallMountedList() ([]*WallMountedItem, error)
+	WallMountedListContext(ctx context.Context) ([]*WallMountedItem, error)
+	WriteSnapshot(ctx context.Context, dir string) error
+}
+
+var _ ACNHClient = (*Client)(nil)
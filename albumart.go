@@ -0,0 +1,233 @@
+package goacnh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// WithAlbumArt makes song downloads embed the song's image from the API in the
+// MP3 file as its front cover, in an ID3 APIC frame, so that music players
+// show it. Any other ID3 tags in the file are kept. If the image cannot be
+// downloaded, the song is saved without it. Songs downloaded by clients that
+// resume downloads are saved as the API serves them, which is logged for each
+// song, and those of clients that skip existing files are taken to be complete
+// if they are at least the size the server reports.
+func WithAlbumArt() Option {
+	return func(c *Client) {
+		c.albumArt = true
+	}
+}
+
+// songCoverArt describes the image of a song, which is embedded in it as its
+// cover art.
+func songCoverArt(song *Song) *downloadRequest {
+	return &downloadRequest{
+		endpoint: imageEndpoint,
+		pathParams: map[string]string{
+			"category":   string(SongCategory),
+			"resourceID": strconv.Itoa(song.ID),
+		},
+		description: "song image",
+		entity:      song,
+	}
+}
+
+// withCoverArt returns a writer that writes a download to w with the cover art
+// of req embedded, for clients that embed album art in downloads that have
+// any. Otherwise, or if the cover art cannot be downloaded, nil is returned.
+func (c *Client) withCoverArt(ctx context.Context, req downloadRequest, w io.Writer) *coverArtWriter {
+	if !c.albumArt || req.coverArt == nil {
+		return nil
+	}
+	image, mimeType, err := c.fetchCoverArt(ctx, *req.coverArt)
+	if err != nil {
+		c.logf("saving %s without cover art: %v", req.description, err)
+		return nil
+	}
+	return &coverArtWriter{w: w, frame: apicFrameBody(image, mimeType)}
+}
+
+// fetchCoverArt downloads the image described by req, returning it and its
+// media type.
+func (c *Client) fetchCoverArt(ctx context.Context, req downloadRequest) ([]byte, string, error) {
+	resp, err := c.getAsset(ctx, req, func(r *resty.Request) *resty.Request {
+		return r.SetDoNotParseResponse(true)
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	defer resp.RawBody().Close()
+	if err := checkResponse(resp); err != nil {
+		return nil, "", err
+	}
+	image, err := io.ReadAll(resp.RawBody())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	mimeType, _, _ := mime.ParseMediaType(resp.Header().Get("Content-Type"))
+	if !strings.HasPrefix(mimeType, "image/") {
+		mimeType = "image/png"
+	}
+	return image, mimeType, nil
+}
+
+const (
+	id3HeaderLength int = 10
+	// id3FlagUnsynchronisation, id3FlagExtendedHeader and id3FlagFooter are
+	// flags of an ID3v2 tag header.
+	id3FlagUnsynchronisation byte = 0x80
+	id3FlagExtendedHeader    byte = 0x40
+	id3FlagFooter            byte = 0x10
+	// apicPictureFrontCover is the APIC picture type of a front cover.
+	apicPictureFrontCover byte = 0x03
+)
+
+// coverArtWriter writes an MP3 file to w with an APIC frame added to its ID3v2
+// tag, or with a tag holding just the frame added if it has none. It holds
+// back the start of the file until it has seen the whole tag, if there is one,
+// and must be flushed once the file is written.
+type coverArtWriter struct {
+	w io.Writer
+	// frame is the body of the APIC frame to add.
+	frame []byte
+	// head holds the start of the file while its tag is read.
+	head []byte
+	done bool
+	// size is the number of bytes written to w.
+	size int64
+}
+
+// Write writes p, returning the number of bytes of it that were taken, rather
+// than the number written to w, so that the size of the download can still be
+// checked.
+func (cw *coverArtWriter) Write(p []byte) (int, error) {
+	if cw.done {
+		return len(p), cw.write(p)
+	}
+	cw.head = append(cw.head, p...)
+	if len(cw.head) < id3HeaderLength {
+		return len(p), nil
+	}
+	if !bytes.HasPrefix(cw.head, []byte("ID3")) {
+		return len(p), cw.finish(nil)
+	}
+	tagLength := id3HeaderLength + synchsafe(cw.head[6:10])
+	if cw.head[5]&id3FlagFooter != 0 {
+		tagLength += id3HeaderLength
+	}
+	if len(cw.head) < tagLength {
+		return len(p), nil
+	}
+	return len(p), cw.finish(cw.head[:tagLength])
+}
+
+// Flush writes whatever of the file is still held back, which is only the
+// case if it ended before its tag could be read. Files too short to have a tag
+// are given one, and files that ended part way through their tag are left as
+// they are.
+func (cw *coverArtWriter) Flush() error {
+	if cw.done || len(cw.head) == 0 {
+		return nil
+	}
+	if bytes.HasPrefix(cw.head, []byte("ID3")) {
+		cw.done = true
+		return cw.write(cw.head)
+	}
+	return cw.finish(nil)
+}
+
+// finish writes the file's tag, which is nil if it has none, with the APIC
+// frame added, followed by the rest of what is held back.
+func (cw *coverArtWriter) finish(tag []byte) error {
+	cw.done = true
+	rest := cw.head[len(tag):]
+	newTag, ok := addAPICFrame(tag, cw.frame)
+	if !ok {
+		// The tag is of a form that cannot be rewritten safely, so the file is
+		// left as it is.
+		return cw.write(cw.head)
+	}
+	if err := cw.write(newTag); err != nil {
+		return err
+	}
+	return cw.write(rest)
+}
+
+func (cw *coverArtWriter) write(p []byte) error {
+	n, err := cw.w.Write(p)
+	cw.size += int64(n)
+	return err
+}
+
+// apicFrameBody returns the body of an APIC frame holding the given image as
+// the front cover.
+func apicFrameBody(image []byte, mimeType string) []byte {
+	var body bytes.Buffer
+	body.WriteByte(0) // ISO-8859-1 text encoding.
+	body.WriteString(mimeType)
+	body.WriteByte(0)
+	body.WriteByte(apicPictureFrontCover)
+	body.WriteByte(0) // Empty description.
+	body.Write(image)
+	return body.Bytes()
+}
+
+// addAPICFrame returns an ID3v2 tag with the given APIC frame body in place of
+// any APIC frames in tag, keeping its other frames, or a new ID3v2.3 tag
+// holding just the frame if tag is nil. Only ID3v2.3 and ID3v2.4 tags without
+// unsynchronisation or an extended header are rewritten, or false is
+// returned. Padding and any footer are dropped.
+func addAPICFrame(tag []byte, frame []byte) ([]byte, bool) {
+	version, flags := byte(3), byte(0)
+	var frames bytes.Buffer
+	if tag != nil {
+		version, flags = tag[3], tag[5]
+		if (version != 3 && version != 4) || flags&(id3FlagUnsynchronisation|id3FlagExtendedHeader) != 0 {
+			return nil, false
+		}
+		flags &^= id3FlagFooter
+		end := id3HeaderLength + synchsafe(tag[6:10])
+		for i := id3HeaderLength; i+id3HeaderLength <= end && tag[i] != 0; {
+			size := int(tag[i+4])<<24 | int(tag[i+5])<<16 | int(tag[i+6])<<8 | int(tag[i+7])
+			if version == 4 {
+				size = synchsafe(tag[i+4 : i+8])
+			}
+			next := i + id3HeaderLength + size
+			if size < 0 || next > end {
+				return nil, false
+			}
+			if string(tag[i:i+4]) != "APIC" {
+				frames.Write(tag[i:next])
+			}
+			i = next
+		}
+	}
+	frames.WriteString("APIC")
+	if version == 4 {
+		frames.Write(toSynchsafe(len(frame)))
+	} else {
+		size := len(frame)
+		frames.Write([]byte{byte(size >> 24), byte(size >> 16), byte(size >> 8), byte(size)})
+	}
+	frames.Write([]byte{0, 0})
+	frames.Write(frame)
+	newTag := append([]byte{'I', 'D', '3', version, 0, flags}, toSynchsafe(frames.Len())...)
+	return append(newTag, frames.Bytes()...), true
+}
+
+// synchsafe decodes a 4 byte synchsafe integer, of which only the low 7 bits
+// of each byte are used.
+func synchsafe(b []byte) int {
+	return int(b[0]&0x7F)<<21 | int(b[1]&0x7F)<<14 | int(b[2]&0x7F)<<7 | int(b[3]&0x7F)
+}
+
+func toSynchsafe(n int) []byte {
+	return []byte{byte(n>>21) & 0x7F, byte(n>>14) & 0x7F, byte(n>>7) & 0x7F, byte(n) & 0x7F}
+}
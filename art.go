@@ -0,0 +1,84 @@
+package goacnh
+
+import "context"
+
+// Art represents a piece of artwork that can be bought from Redd and donated to
+// the museum, as represented via the API. HasFake is set if Redd may also sell
+// a forgery of the piece.
+type Art struct {
+	ID         int               `json:"id"`
+	FileName   string            `json:"file-name"`
+	Name       map[string]string `json:"name"`
+	HasFake    bool              `json:"hasFake"`
+	BuyPrice   int               `json:"buy-price"`
+	SellPrice  int               `json:"sell-price"`
+	ImageURI   string            `json:"image_uri"`
+	MuseumDesc string            `json:"museum-desc"`
+}
+
+var artResource = &resource[Art]{
+	category:        ArtCategory,
+	description:     "art",
+	listDescription: "art list",
+	list:            artListEndpoint,
+	stream:          streamList[Art],
+	item:            artEndpoint,
+	idParam:         "artID",
+	id:              func(a *Art) int { return a.ID },
+	names:           func(a *Art) []map[string]string { return []map[string]string{a.Name} },
+}
+
+// ArtList returns all the artwork that the API provides. An error is returned
+// if the request failed or a non 200 error code was returned. Use
+// ArtListContext to control cancellation and deadlines.
+func (c *Client) ArtList() ([]*Art, error) {
+	return c.ArtListContext(context.Background())
+}
+
+// ArtListContext is like ArtList but makes its requests with the given context.
+func (c *Client) ArtListContext(ctx context.Context) ([]*Art, error) {
+	return artResource.listAll(ctx, c)
+}
+
+// ArtByID gets a single artwork based on the ID provided. An error is returned
+// if the request failed or a non 200 error code was returned. Use
+// ArtByIDContext to control cancellation and deadlines.
+func (c *Client) ArtByID(id int) (*Art, error) {
+	return c.ArtByIDContext(context.Background(), id)
+}
+
+// ArtByIDContext is like ArtByID but makes its requests with the given context.
+func (c *Client) ArtByIDContext(ctx context.Context, id int) (*Art, error) {
+	return artResource.byID(ctx, c, id)
+}
+
+// ArtByIDs gets the pieces of art with each of the given IDs, making several
+// requests at once, up to the limit set by WithConcurrency. They are returned
+// in the order of their IDs. If any could not be got, the rest are still
+// returned, with nil in place of those that failed, along with a *BatchError
+// holding the error of each. Use ArtByIDsContext to control cancellation and
+// deadlines.
+func (c *Client) ArtByIDs(ids []int) ([]*Art, error) {
+	return c.ArtByIDsContext(context.Background(), ids)
+}
+
+// ArtByIDsContext is like ArtByIDs but makes its requests with the given
+// context.
+func (c *Client) ArtByIDsContext(ctx context.Context, ids []int) ([]*Art, error) {
+	return artResource.byIDs(ctx, c, ids)
+}
+
+// ArtByName get an artwork based on its name. The name is compared with its
+// names in each of the client's languages, and then in any other language. An
+// error is returned if the request failed or a non 200 error code was returned
+// or no match was found. Use ArtByNameContext to control cancellation and
+// deadlines.
+func (c *Client) ArtByName(name string) (*Art, error) {
+	return c.ArtByNameContext(context.Background(), name)
+}
+
+// ArtByNameContext is like ArtByName but makes its requests with the given
+// context.
+func (c *Client) ArtByNameContext(ctx context.Context, name string) (*Art, error) {
+	return artResource.byName(ctx, c, name)
+}
@@ -0,0 +1,145 @@
+package goacnh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// WithAssetBaseURL sets the URL that images and audio files are fetched from,
+// such as a CDN or mirror, when it differs from the URL of the API's JSON. It
+// also applies to the URLs built by ImageURL, IconURL, SongURL and BGMURL, and
+// to URLs from the API resolved by AssetURL. By default assets come from the
+// same URL as the JSON.
+func WithAssetBaseURL(assetBaseURL string) Option {
+	return func(c *Client) {
+		c.assetBaseURL = strings.TrimRight(assetBaseURL, "/")
+	}
+}
+
+// ErrAssetExpired matches any AssetExpiredError via errors.Is.
+var ErrAssetExpired = errors.New("asset url has expired")
+
+// AssetExpiredError is returned when an image or audio file is refused with a
+// 403 or 410 status code, as mirrors do once a signed URL has expired, and it
+// could not be resolved to a URL that works.
+type AssetExpiredError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *AssetExpiredError) Error() string {
+	return fmt.Sprintf("asset url has expired (%d): %s", e.StatusCode, e.URL)
+}
+
+// Is reports whether target is ErrAssetExpired.
+func (e *AssetExpiredError) Is(target error) bool {
+	return target == ErrAssetExpired
+}
+
+// AssetResolver gives a fresh URL for an image or audio file whose URL has
+// expired, such as by signing it again for a mirror. It is given the full URL
+// that was refused.
+type AssetResolver interface {
+	ResolveAsset(ctx context.Context, expiredURL string) (string, error)
+}
+
+// AssetResolverFunc allows an ordinary function to be used as an
+// AssetResolver.
+type AssetResolverFunc func(ctx context.Context, expiredURL string) (string, error)
+
+// ResolveAsset calls f(ctx, expiredURL).
+func (f AssetResolverFunc) ResolveAsset(ctx context.Context, expiredURL string) (string, error) {
+	return f(ctx, expiredURL)
+}
+
+// WithAssetResolver sets the resolver used to get a fresh URL for an image or
+// audio file that is refused with a 403 or 410 status code. The download is
+// tried once more from the fresh URL. Without a resolver, such downloads fail
+// with an AssetExpiredError.
+func WithAssetResolver(resolver AssetResolver) Option {
+	return func(c *Client) {
+		c.assetResolver = resolver
+	}
+}
+
+// ImageURL returns the URL of the PNG image of the resource with the given ID
+// in the given category, so that it can be linked to rather than downloaded.
+func (c *Client) ImageURL(category Category, id int) string {
+	return c.expandAssetURL(imageEndpoint, map[string]string{
+		"category":   string(category),
+		"resourceID": strconv.Itoa(id),
+	})
+}
+
+// IconURL returns the URL of the PNG icon of the resource with the given ID in
+// the given category.
+func (c *Client) IconURL(category Category, id int) string {
+	return c.expandAssetURL(iconEndpoint, map[string]string{
+		"category":   string(category),
+		"resourceID": strconv.Itoa(id),
+	})
+}
+
+// SongURL returns the URL the MP3 file of the given song can be streamed from.
+func (c *Client) SongURL(song *Song) string {
+	return c.expandAssetURL(songFileEndpoint, map[string]string{
+		"songID": strconv.Itoa(song.ID),
+	})
+}
+
+// BGMURL returns the URL the MP3 file of the given background music track can
+// be streamed from.
+func (c *Client) BGMURL(track *BGMTrack) string {
+	return c.expandAssetURL(bgmFileEndpoint, map[string]string{
+		"trackID": strconv.Itoa(track.ID),
+	})
+}
+
+// AssetURL resolves an asset URL given by the API, such as the ImageURI of a
+// fish, against the asset base URL set by WithAssetBaseURL. Its path is kept
+// and its scheme and host are replaced. URLs are returned unchanged if there
+// is no asset base URL or they cannot be parsed.
+func (c *Client) AssetURL(uri string) string {
+	if c.assetBaseURL == "" {
+		return uri
+	}
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() {
+		return uri
+	}
+	resolved := c.assetBaseURL + u.EscapedPath()
+	if u.RawQuery != "" {
+		resolved += "?" + u.RawQuery
+	}
+	return resolved
+}
+
+// assetPath returns the path, or full URL, that requests to an endpoint are
+// made to. Asset endpoints are moved to the asset base URL if one is set.
+func (c *Client) assetPath(ep endpoint) string {
+	if !ep.asset || c.assetBaseURL == "" {
+		return ep.path
+	}
+	if strings.Contains(ep.path, "://") {
+		return c.AssetURL(ep.path)
+	}
+	return c.assetBaseURL + ep.path
+}
+
+// expandAssetURL returns the full URL of an asset endpoint with its path
+// parameters filled in.
+func (c *Client) expandAssetURL(ep endpoint, pathParams map[string]string) string {
+	path := c.assetPath(ep)
+	if !strings.Contains(path, "://") {
+		path = strings.TrimRight(c.baseURL, "/") + path
+	}
+	replacements := []string{"{apiVersion}", strconv.Itoa(apiVersion)}
+	for key, value := range pathParams {
+		replacements = append(replacements, "{"+key+"}", url.PathEscape(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(path)
+}
@@ -0,0 +1,43 @@
+package goacnh
+
+// Availability describes when and where a creature can be caught, as
+// represented via the API.
+type Availability struct {
+	MonthNorthern      string `json:"month-northern"`
+	MonthSouthern      string `json:"month-southern"`
+	Time               string `json:"time"`
+	IsAllDay           bool   `json:"isAllDay"`
+	IsAllYear          bool   `json:"isAllYear"`
+	Location           string `json:"location"`
+	Rarity             string `json:"rarity"`
+	MonthArrayNorthern []int  `json:"month-array-northern"`
+	MonthArraySouthern []int  `json:"month-array-southern"`
+	TimeArray          []int  `json:"time-array"`
+}
+
+// Hemisphere is a hemisphere of the game world. Creatures are available in
+// different months in each hemisphere.
+type Hemisphere int
+
+const (
+	NorthernHemisphere Hemisphere = iota
+	SouthernHemisphere
+)
+
+// String returns the name of the hemisphere as used by the API, that is
+// "northern" or "southern".
+func (h Hemisphere) String() string {
+	if h == SouthernHemisphere {
+		return "southern"
+	}
+	return "northern"
+}
+
+// Months returns the months (1-12) in which the creature is available in the
+// given hemisphere.
+func (a Availability) Months(h Hemisphere) []int {
+	if h == SouthernHemisphere {
+		return a.MonthArraySouthern
+	}
+	return a.MonthArrayNorthern
+}
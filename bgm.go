@@ -1,9 +1,10 @@
 package goacnh
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
-	"path"
 	"strconv"
 )
 
@@ -19,6 +20,17 @@ type BGMTrack struct {
 	Weather  Weather `json:"weather"`
 }
 
+var bgmResource = &resource[BGMTrack]{
+	category:        BGMCategory,
+	description:     "background music track",
+	listDescription: "background music list",
+	list:            bgmListEndpoint,
+	stream:          streamList[BGMTrack],
+	item:            bgmTrackEndpoint,
+	idParam:         "trackID",
+	id:              func(track *BGMTrack) int { return track.ID },
+}
+
 const (
 	SunnyWeather Weather = "Sunny"
 	RainyWeather Weather = "Rainy"
@@ -33,54 +45,60 @@ const (
 
 // BGMList returns all the background music tracks that the API provides. An
 // error is returned if the request failed or a non 200 error code was returned.
+// Use BGMListContext to control cancellation and deadlines.
 func (c *Client) BGMList() ([]*BGMTrack, error) {
-	var bgmMap map[string]*BGMTrack
-	resp, err := c.restClient.R().
-		SetHeader("Accept", "application/json").
-		SetPathParam("apiVersion", strconv.Itoa(1)).
-		SetResult(&bgmMap).
-		Get("/v{apiVersion}/backgroundmusic")
-	if err != nil {
-		return nil, fmt.Errorf("failed to request background music list: %w", err)
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("received non-200 status code (%d)", resp.StatusCode())
-	}
-	bgmList := make([]*BGMTrack, 0)
-	for _, value := range bgmMap {
-		bgmList = append(bgmList, value)
-	}
-	return bgmList, nil
+	return c.BGMListContext(context.Background())
+}
+
+// BGMListContext is like BGMList but makes its requests with the given context.
+func (c *Client) BGMListContext(ctx context.Context) ([]*BGMTrack, error) {
+	return bgmResource.listAll(ctx, c)
 }
 
 // BGMTrackByID gets a single background music track based on the ID provided.
 // An error is returned if the request failed or a non 200 error code was
-// returned.
+// returned. Use BGMTrackByIDContext to control cancellation and deadlines.
 func (c *Client) BGMTrackByID(id int) (*BGMTrack, error) {
-	var bgmTrack *BGMTrack
-	resp, err := c.restClient.R().
-		SetHeader("Accept", "application/json").
-		SetPathParam("apiVersion", strconv.Itoa(1)).
-		SetPathParam("trackID", strconv.Itoa(id)).
-		SetResult(&bgmTrack).
-		Get("/v{apiVersion}/backgroundmusic/{trackID}")
-	if err != nil {
-		return nil, fmt.Errorf("failed to request background music track: %w", err)
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("received non-200 status code (%d)", resp.StatusCode())
-	}
-	return bgmTrack, nil
+	return c.BGMTrackByIDContext(context.Background(), id)
+}
+
+// BGMTrackByIDContext is like BGMTrackByID but makes its requests with the
+// given context.
+func (c *Client) BGMTrackByIDContext(ctx context.Context, id int) (*BGMTrack, error) {
+	return bgmResource.byID(ctx, c, id)
+}
+
+// BGMTracksByIDs gets the background music tracks with each of the given IDs,
+// making several requests at once, up to the limit set by WithConcurrency. They
+// are returned in the order of their IDs. If any could not be got, the rest are
+// still returned, with nil in place of those that failed, along with a
+// *BatchError holding the error of each. Use BGMTracksByIDsContext to control
+// cancellation and deadlines.
+func (c *Client) BGMTracksByIDs(ids []int) ([]*BGMTrack, error) {
+	return c.BGMTracksByIDsContext(context.Background(), ids)
+}
+
+// BGMTracksByIDsContext is like BGMTracksByIDs but makes its requests with the
+// given context.
+func (c *Client) BGMTracksByIDsContext(ctx context.Context, ids []int) ([]*BGMTrack, error) {
+	return bgmResource.byIDs(ctx, c, ids)
 }
 
 // BGMListByHour gets all the background music tracks that can be played in a
 // given hour, regardless of the weather. An error is returned if the request
-// failed or a non 200 error code was returned or no match was found.
+// failed or a non 200 error code was returned or no match was found. Use
+// BGMListByHourContext to control cancellation and deadlines.
 func (c *Client) BGMListByHour(hour int) ([]*BGMTrack, error) {
+	return c.BGMListByHourContext(context.Background(), hour)
+}
+
+// BGMListByHourContext is like BGMListByHour but makes its requests with the
+// given context.
+func (c *Client) BGMListByHourContext(ctx context.Context, hour int) ([]*BGMTrack, error) {
 	if hour > bgmMaxHour || hour < bgmMinHour {
-		return nil, fmt.Errorf("hour must be between %d and %d", bgmMinHour, bgmMaxHour)
+		return nil, fmt.Errorf("%w: hour must be between %d and %d", ErrInvalidHour, bgmMinHour, bgmMaxHour)
 	}
-	bgmList, err := c.BGMList()
+	bgmList, err := c.BGMListContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +109,7 @@ func (c *Client) BGMListByHour(hour int) ([]*BGMTrack, error) {
 		}
 	}
 	if len(matchedList) == 0 {
-		return nil, fmt.Errorf("failed to find a match")
+		return nil, ErrNotFound
 	}
 	return matchedList, nil
 }
@@ -99,11 +117,18 @@ func (c *Client) BGMListByHour(hour int) ([]*BGMTrack, error) {
 // BGMListByWeather gets all the background music tracks that can be played in a
 // given weather condition, regardless of the time. An error is returned if the
 // request failed or a non 200 error code was returned or no match was found.
+// Use BGMListByWeatherContext to control cancellation and deadlines.
 func (c *Client) BGMListByWeather(weather Weather) ([]*BGMTrack, error) {
+	return c.BGMListByWeatherContext(context.Background(), weather)
+}
+
+// BGMListByWeatherContext is like BGMListByWeather but makes its requests with
+// the given context.
+func (c *Client) BGMListByWeatherContext(ctx context.Context, weather Weather) ([]*BGMTrack, error) {
 	if weather != RainyWeather && weather != SunnyWeather && weather != SnowyWeather {
-		return nil, fmt.Errorf("weather must be %s, %s, or %s", RainyWeather, SunnyWeather, SnowyWeather)
+		return nil, fmt.Errorf("%w: weather must be %s, %s, or %s", ErrInvalidWeather, RainyWeather, SunnyWeather, SnowyWeather)
 	}
-	bgmList, err := c.BGMList()
+	bgmList, err := c.BGMListContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -114,22 +139,29 @@ func (c *Client) BGMListByWeather(weather Weather) ([]*BGMTrack, error) {
 		}
 	}
 	if len(matchedList) == 0 {
-		return nil, fmt.Errorf("failed to find a match")
+		return nil, ErrNotFound
 	}
 	return matchedList, nil
 }
 
-// BGMTrackByQuery gets the background music track that can be played in a
-// given weather condition, at a specified hour. An error is returned if the
-// request failed or a non 200 error code was returned or no match was found.
+// BGMTrackByQuery gets the background music track that can be played in a given
+// weather condition, at a specified hour. An error is returned if the request
+// failed or a non 200 error code was returned or no match was found. Use
+// BGMTrackByQueryContext to control cancellation and deadlines.
 func (c *Client) BGMTrackByQuery(hour int, weather Weather) (*BGMTrack, error) {
+	return c.BGMTrackByQueryContext(context.Background(), hour, weather)
+}
+
+// BGMTrackByQueryContext is like BGMTrackByQuery but makes its requests with
+// the given context.
+func (c *Client) BGMTrackByQueryContext(ctx context.Context, hour int, weather Weather) (*BGMTrack, error) {
 	if hour > bgmMaxHour || hour < bgmMinHour {
-		return nil, fmt.Errorf("hour must be between %d and %d", bgmMinHour, bgmMaxHour)
+		return nil, fmt.Errorf("%w: hour must be between %d and %d", ErrInvalidHour, bgmMinHour, bgmMaxHour)
 	}
 	if weather != RainyWeather && weather != SunnyWeather && weather != SnowyWeather {
-		return nil, fmt.Errorf("weather must be %s, %s, or %s", RainyWeather, SunnyWeather, SnowyWeather)
+		return nil, fmt.Errorf("%w: weather must be %s, %s, or %s", ErrInvalidWeather, RainyWeather, SunnyWeather, SnowyWeather)
 	}
-	bgmList, err := c.BGMList()
+	bgmList, err := c.BGMListContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -138,36 +170,63 @@ func (c *Client) BGMTrackByQuery(hour int, weather Weather) (*BGMTrack, error) {
 			return track, nil
 		}
 	}
-	return nil, fmt.Errorf("failed to find a match")
+	return nil, ErrNotFound
 }
 
 // BGMDownload downloads the given track as an MP3 file to a given directory.
 // The file name of the download is that specified as the file name by the API.
 // The given download dir must exist before calling this. Returned is the file
-// path of the download song, provided there was no error.
+// path of the download song, provided there was no error. In dry-run mode the
+// path is returned without anything being downloaded. Use BGMDownloadContext to
+// control cancellation and deadlines.
 func (c *Client) BGMDownload(track *BGMTrack, downloadDirectory string) (string, error) {
-	if !dirExists(downloadDirectory) {
-		return "", fmt.Errorf("destination download directory does not exist")
-	}
-	outputFilePath := path.Join(downloadDirectory, track.FileName) + bgmFileExtension
-	resp, err := c.restClient.R().
-		SetHeader("Accept", "application/json").
-		SetPathParam("apiVersion", strconv.Itoa(1)).
-		SetPathParam("trackID", strconv.Itoa(track.ID)).
-		SetOutput(outputFilePath).
-		Get("/v{apiVersion}/hourly/{trackID}")
-	if err != nil {
-		return "", fmt.Errorf("failed to download background music track: %w", err)
-	}
-	if resp.StatusCode() != 200 {
-		return "", fmt.Errorf("received non-200 status code (%d)", resp.StatusCode())
+	return c.BGMDownloadContext(context.Background(), track, downloadDirectory)
+}
+
+// BGMDownloadContext is like BGMDownload but makes its requests with the given
+// context.
+func (c *Client) BGMDownloadContext(ctx context.Context, track *BGMTrack, downloadDirectory string) (string, error) {
+	return c.download(ctx, bgmDownloadRequest(track), downloadDirectory)
+}
+
+// bgmDownloadRequest describes the MP3 file of a background music track.
+func bgmDownloadRequest(track *BGMTrack) downloadRequest {
+	return downloadRequest{
+		endpoint: bgmFileEndpoint,
+		pathParams: map[string]string{
+			"trackID": strconv.Itoa(track.ID),
+		},
+		fileName:    track.FileName + bgmFileExtension,
+		description: "background music track",
+		audio:       true,
+		entity:      track,
 	}
-	return outputFilePath, nil
 }
 
-// BGMDownloadTemp downloads the given track as an MP3 file to a temp directory. Th
-// file name of the download is that specified as the file name by the API.
+// BGMDownloadTo writes the MP3 file of the given track to w, such as an HTTP
+// response, without touching the local filesystem. An error is returned if the
+// request failed or a non 200 error code was returned. Use
+// BGMDownloadToContext to control cancellation and deadlines.
+func (c *Client) BGMDownloadTo(track *BGMTrack, w io.Writer) error {
+	return c.BGMDownloadToContext(context.Background(), track, w)
+}
+
+// BGMDownloadToContext is like BGMDownloadTo but makes its requests with the
+// given context.
+func (c *Client) BGMDownloadToContext(ctx context.Context, track *BGMTrack, w io.Writer) error {
+	return c.downloadTo(ctx, bgmDownloadRequest(track), w)
+}
+
+// BGMDownloadTemp downloads the given track as an MP3 file to a temp directory.
+// Th file name of the download is that specified as the file name by the API.
 // Returned is the file path of the download song, provided there was no error.
+// Use BGMDownloadTempContext to control cancellation and deadlines.
 func (c *Client) BGMDownloadTemp(track *BGMTrack) (string, error) {
-	return c.BGMDownload(track, os.TempDir())
+	return c.BGMDownloadTempContext(context.Background(), track)
+}
+
+// BGMDownloadTempContext is like BGMDownloadTemp but makes its requests with
+// the given context.
+func (c *Client) BGMDownloadTempContext(ctx context.Context, track *BGMTrack) (string, error) {
+	return c.BGMDownloadContext(ctx, track, os.TempDir())
 }
@@ -0,0 +1,100 @@
+package goacnh
+
+import (
+	"context"
+	"sort"
+)
+
+// bgmWeathers lists every weather that background music is played in, in the
+// order reports list them.
+var bgmWeathers = []Weather{SunnyWeather, RainyWeather, SnowyWeather}
+
+// BGMSlot is an hour of the day and a weather condition, for which the game
+// plays exactly one background music track.
+type BGMSlot struct {
+	Hour    int
+	Weather Weather
+}
+
+// BGMDuplicate lists the tracks that share a single slot.
+type BGMDuplicate struct {
+	Slot   BGMSlot
+	Tracks []*BGMTrack
+}
+
+// BGMReport describes how completely a set of background music tracks covers
+// the 24 hours and 3 weather conditions of the game.
+type BGMReport struct {
+	// Missing lists the slots that no track is played in, ordered by hour and
+	// then weather.
+	Missing []BGMSlot
+	// Duplicates lists the slots that more than one track is played in,
+	// ordered by hour and then weather.
+	Duplicates []BGMDuplicate
+	// Invalid lists the tracks with an hour or weather outside of those the
+	// game uses.
+	Invalid []*BGMTrack
+}
+
+// OK reports whether every slot has exactly one track and no track is invalid.
+func (r *BGMReport) OK() bool {
+	return len(r.Missing) == 0 && len(r.Duplicates) == 0 && len(r.Invalid) == 0
+}
+
+// CheckBGMTracks reports on how completely the given tracks cover every hour
+// and weather condition, so that incomplete data from a mirror or an offline
+// snapshot can be caught.
+func CheckBGMTracks(tracks []*BGMTrack) *BGMReport {
+	report := &BGMReport{}
+	slots := make(map[BGMSlot][]*BGMTrack)
+	for _, track := range tracks {
+		if track.Hour < bgmMinHour || track.Hour > bgmMaxHour || weatherIndex(track.Weather) < 0 {
+			report.Invalid = append(report.Invalid, track)
+			continue
+		}
+		slot := BGMSlot{Hour: track.Hour, Weather: track.Weather}
+		slots[slot] = append(slots[slot], track)
+	}
+	for hour := bgmMinHour; hour <= bgmMaxHour; hour++ {
+		for _, weather := range bgmWeathers {
+			slot := BGMSlot{Hour: hour, Weather: weather}
+			switch matched := slots[slot]; {
+			case len(matched) == 0:
+				report.Missing = append(report.Missing, slot)
+			case len(matched) > 1:
+				sort.Slice(matched, func(i, j int) bool {
+					return matched[i].ID < matched[j].ID
+				})
+				report.Duplicates = append(report.Duplicates, BGMDuplicate{Slot: slot, Tracks: matched})
+			}
+		}
+	}
+	return report
+}
+
+// BGMReport fetches every background music track and reports on how
+// completely they cover every hour and weather condition. An error is returned
+// if the request failed or a non 200 error code was returned. Use
+// BGMReportContext to control cancellation and deadlines.
+func (c *Client) BGMReport() (*BGMReport, error) {
+	return c.BGMReportContext(context.Background())
+}
+
+// BGMReportContext is like BGMReport but makes its requests with the given
+// context.
+func (c *Client) BGMReportContext(ctx context.Context) (*BGMReport, error) {
+	bgmList, err := c.BGMListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return CheckBGMTracks(bgmList), nil
+}
+
+func weatherIndex(weather Weather) int {
+	for i, w := range bgmWeathers {
+		if w == weather {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,97 @@
+package goacnh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned, without a request being made, while the circuit
+// breaker set by WithCircuitBreaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// WithCircuitBreaker makes the client stop sending requests for the cooldown
+// period after threshold consecutive requests fail with a network error or a
+// 429 or 5xx status code. While it is open requests fail fast with
+// ErrCircuitOpen, and lists that were cached by WithCache are served even if
+// they have expired. Once the cooldown has passed a single request is let
+// through, which closes the breaker if it succeeds and opens it again if not.
+// Each attempt of a retried request counts as a request.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(c *Client) {
+		if threshold <= 0 {
+			c.breaker = nil
+			return
+		}
+		c.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown}
+	}
+}
+
+// circuitBreaker is a round tripper that stops passing requests on after too
+// many consecutive failures.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	next      http.RoundTripper
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func (b *circuitBreaker) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := b.next.RoundTrip(req)
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		// A cancelled request says nothing about the health of the API.
+		b.abandon()
+	case err != nil:
+		b.record(true)
+	default:
+		b.record(IsRetryable(&APIError{StatusCode: resp.StatusCode}))
+	}
+	return resp, err
+}
+
+// allow returns ErrCircuitOpen if a request may not be sent now.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return nil
+	}
+	if b.trial || time.Now().Before(b.openUntil) {
+		return fmt.Errorf("%w until %s", ErrCircuitOpen, b.openUntil.Format(time.RFC3339))
+	}
+	b.trial = true
+	return nil
+}
+
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// abandon ends a request that was cancelled without counting it as either a
+// success or a failure, letting another trial request through if it was one.
+func (b *circuitBreaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
@@ -0,0 +1,143 @@
+package goacnh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	// Each step sends a request that the API answers with status, or fails
+	// with err, after the cooldown has passed if expire is set. Steps that only
+	// allow check whether a request could be sent, without sending one.
+	type step struct {
+		expire   bool
+		allow    bool
+		status   int
+		err      error
+		wantOpen bool
+	}
+	failed := errors.New("connection reset")
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "opens at threshold",
+			steps: []step{
+				{err: failed},
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusOK, wantOpen: true},
+			},
+		},
+		{
+			name: "success resets failures",
+			steps: []step{
+				{err: failed},
+				{status: http.StatusOK},
+				{err: failed},
+				{status: http.StatusOK},
+			},
+		},
+		{
+			name: "client errors are not failures",
+			steps: []step{
+				{status: http.StatusNotFound},
+				{status: http.StatusBadRequest},
+				{status: http.StatusOK},
+			},
+		},
+		{
+			name: "rate limiting is a failure",
+			steps: []step{
+				{status: http.StatusTooManyRequests},
+				{status: http.StatusTooManyRequests},
+				{status: http.StatusOK, wantOpen: true},
+			},
+		},
+		{
+			name: "half-open trial success closes",
+			steps: []step{
+				{err: failed},
+				{err: failed},
+				{status: http.StatusOK, wantOpen: true},
+				{expire: true, status: http.StatusOK},
+				{err: failed},
+				{status: http.StatusOK},
+			},
+		},
+		{
+			name: "half-open trial failure reopens",
+			steps: []step{
+				{err: failed},
+				{err: failed},
+				{expire: true, status: http.StatusBadGateway},
+				{status: http.StatusOK, wantOpen: true},
+			},
+		},
+		{
+			name: "half-open allows a single trial",
+			steps: []step{
+				{err: failed},
+				{err: failed},
+				{expire: true, allow: true},
+				{allow: true, wantOpen: true},
+				{status: http.StatusOK, wantOpen: true},
+			},
+		},
+		{
+			name: "cancelled trial lets another through",
+			steps: []step{
+				{err: failed},
+				{err: failed},
+				{expire: true, err: context.Canceled},
+				{status: http.StatusOK},
+				{status: http.StatusOK},
+			},
+		},
+		{
+			name: "cancellation is not a failure",
+			steps: []step{
+				{err: failed},
+				{err: context.DeadlineExceeded},
+				{status: http.StatusOK},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := &circuitBreaker{threshold: 2, cooldown: time.Hour}
+			for i, step := range test.steps {
+				if step.expire {
+					b.mu.Lock()
+					b.openUntil = time.Now().Add(-time.Second)
+					b.mu.Unlock()
+				}
+				if step.allow {
+					if err := b.allow(); errors.Is(err, ErrCircuitOpen) != step.wantOpen {
+						t.Fatalf("step %d: allow() = %v, want open %t", i, err, step.wantOpen)
+					}
+					continue
+				}
+				sent := false
+				b.next = RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+					sent = true
+					if step.err != nil {
+						return nil, step.err
+					}
+					return &http.Response{StatusCode: step.status, Body: http.NoBody, Request: req}, nil
+				})
+				req, _ := http.NewRequest(http.MethodGet, "https://acnhapi.com/v1/fish", nil)
+				_, err := b.RoundTrip(req)
+				if open := errors.Is(err, ErrCircuitOpen); open != step.wantOpen {
+					t.Fatalf("step %d: RoundTrip() = %v, want open %t", i, err, step.wantOpen)
+				}
+				if sent == step.wantOpen {
+					t.Fatalf("step %d: request sent = %t, want %t", i, sent, !step.wantOpen)
+				}
+			}
+		})
+	}
+}
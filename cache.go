@@ -0,0 +1,115 @@
+package goacnh
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// responseCache holds decoded responses from cacheable endpoints for a limited
+// time, so that lookups which filter a full list, such as BGMListByHour, do
+// not fetch it again on every call. A nil cache stores nothing.
+type responseCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// WithCache makes the client keep the lists it fetches in memory for the given
+// time to live, and serve later requests for them from memory. Entities in the
+// cached lists are shared between callers, so they should not be modified. By
+// default nothing is cached.
+func WithCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		if ttl <= 0 {
+			c.cache = nil
+			return
+		}
+		c.cache = &responseCache{ttl: ttl, entries: make(map[string]cacheEntry)}
+	}
+}
+
+// InvalidateCache removes everything from the client's in-memory cache, so
+// that the next request for each list is sent to the API.
+func (c *Client) InvalidateCache() {
+	if c.cache == nil {
+		return
+	}
+	c.cache.mu.Lock()
+	defer c.cache.mu.Unlock()
+	c.cache.entries = make(map[string]cacheEntry)
+}
+
+func (rc *responseCache) load(ep endpoint) (interface{}, bool) {
+	if rc == nil || !ep.cacheable {
+		return nil, false
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	entry, ok := rc.entries[ep.path]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// loadStale returns the value cached for an endpoint even if it has expired.
+func (rc *responseCache) loadStale(ep endpoint) (interface{}, bool) {
+	if rc == nil || !ep.cacheable {
+		return nil, false
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	entry, ok := rc.entries[ep.path]
+	return entry.value, ok
+}
+
+func (rc *responseCache) store(ep endpoint, value interface{}) {
+	if rc == nil || !ep.cacheable {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.entries[ep.path] = cacheEntry{value: value, expires: time.Now().Add(rc.ttl)}
+}
+
+// cachedList returns a copy of the list cached for the given endpoint, if it
+// has one that has not expired.
+func cachedList[T any](c *Client, ep endpoint) ([]*T, bool) {
+	if c.cache == nil || !ep.cacheable {
+		return nil, false
+	}
+	value, ok := c.cache.load(ep)
+	c.observeCache("memory", ok)
+	if !ok {
+		return nil, false
+	}
+	return append([]*T(nil), value.([]*T)...), true
+}
+
+// staleList returns a copy of the list cached for the given endpoint, even if
+// it has expired, when err shows that the circuit breaker is open. Otherwise,
+// or if nothing is cached, it returns err.
+func staleList[T any](c *Client, ep endpoint, err error) ([]*T, error) {
+	if !errors.Is(err, ErrCircuitOpen) {
+		return nil, err
+	}
+	value, ok := c.cache.loadStale(ep)
+	if !ok {
+		return nil, err
+	}
+	return append([]*T(nil), value.([]*T)...), nil
+}
+
+// storeList caches a copy of a list fetched from the given endpoint.
+func storeList[T any](c *Client, ep endpoint, list []*T) {
+	c.cache.store(ep, append([]*T(nil), list...))
+}
@@ -0,0 +1,139 @@
+package goacnh
+
+import (
+	"errors"
+	"io/fs"
+)
+
+// Capabilities reports which features of a client can be used with the way it
+// was configured, so that applications can hide what is unsupported instead of
+// failing when it is used.
+type Capabilities struct {
+	// Categories lists, in order, the categories that can be listed and
+	// looked up in. Recipes are included if a RecipeProvider was given.
+	Categories []Category
+	// Network reports whether the client makes requests at all, which
+	// ListSince, CategoryJSON, WriteSnapshot and the asset checks of
+	// CoverageReport and CheckIntegrity rely on.
+	Network bool
+	// Downloads reports whether images and audio files can be downloaded for
+	// the entities the client provides, which they cannot be when offline or
+	// when entities come from a source, such as Nookipedia, whose IDs are not
+	// those the files are served under.
+	Downloads bool
+}
+
+// Has reports whether the given category can be listed and looked up in.
+func (c *Capabilities) Has(category Category) bool {
+	return containsCategory(c.Categories, category)
+}
+
+// Capabilities reports which features of the client can be used. A client
+// reading from the API, or from a Source that cannot tell what it provides,
+// is reported to provide every category, with files that can be downloaded
+// unless it is offline.
+func (c *Client) Capabilities() *Capabilities {
+	network := c.offlineData == nil
+	capabilities := &Capabilities{
+		Categories: listCategories(),
+		Network:    network,
+		Downloads:  network && sourceDownloads(c.source),
+	}
+	if lister, ok := c.source.(categoryLister); ok {
+		capabilities.Categories = lister.categories()
+	}
+	if c.recipeProvider != nil {
+		capabilities.Categories = append(capabilities.Categories, RecipeCategory)
+	}
+	return capabilities
+}
+
+// categoryLister is implemented by the sources of this package, which can
+// tell which categories they have data for.
+type categoryLister interface {
+	categories() []Category
+}
+
+// downloadReporter is implemented by the sources of this package, which can
+// tell whether the files of their entities can be downloaded by ID.
+type downloadReporter interface {
+	downloads() bool
+}
+
+// sourceDownloads reports whether the files of the entities of source can be
+// downloaded, assuming that they can for a nil source, which reads from the
+// API, and for sources that cannot tell.
+func sourceDownloads(source Source) bool {
+	reporter, ok := source.(downloadReporter)
+	return !ok || reporter.downloads()
+}
+
+func (s fsSource) categories() []Category {
+	var categories []Category
+	for _, category := range listCategories() {
+		_, err := fs.Stat(s.fsys, string(category)+".json")
+		if !errors.Is(err, fs.ErrNotExist) {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+func (s mergedSource) categories() []Category {
+	return unionCategories(s)
+}
+
+func (s fallbackSource) categories() []Category {
+	return unionCategories(s)
+}
+
+// downloads reports whether the files of entities from every one of the
+// merged sources can be downloaded, as any of them may provide an entity.
+func (s mergedSource) downloads() bool {
+	return allDownloads(s)
+}
+
+func (s fallbackSource) downloads() bool {
+	return allDownloads(s)
+}
+
+func allDownloads(sources []Source) bool {
+	for _, source := range sources {
+		if !sourceDownloads(source) {
+			return false
+		}
+	}
+	return true
+}
+
+// unionCategories returns the categories that any of the given sources have
+// data for, assuming that those that cannot tell have data for every one.
+func unionCategories(sources []Source) []Category {
+	var categories []Category
+	for _, category := range listCategories() {
+		for _, source := range sources {
+			lister, ok := source.(categoryLister)
+			if !ok || containsCategory(lister.categories(), category) {
+				categories = append(categories, category)
+				break
+			}
+		}
+	}
+	return categories
+}
+
+func (s *nookipediaSource) categories() []Category {
+	var categories []Category
+	for _, category := range listCategories() {
+		if _, ok := nookipediaPaths[category]; ok {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// downloads reports false, as Nookipedia numbers entities differently from
+// acnhapi.com, or not at all, so their IDs do not name its files.
+func (s *nookipediaSource) downloads() bool {
+	return false
+}
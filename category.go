@@ -0,0 +1,23 @@
+package goacnh
+
+// Category is a collection of resources provided by the API. Its value is the
+// name of the endpoint the collection is served from. Collections that do not
+// come from the API, such as custom entries and recipes, are named in the same
+// style.
+type Category string
+
+const (
+	SongCategory        Category = "songs"
+	BGMCategory         Category = "backgroundmusic"
+	FishCategory        Category = "fish"
+	SeaCreatureCategory Category = "sea"
+	FossilCategory      Category = "fossils"
+	ArtCategory         Category = "art"
+	HousewareCategory   Category = "houseware"
+	WallMountedCategory Category = "wallmounted"
+	MiscCategory        Category = "misc"
+	BugCategory         Category = "bugs"
+	VillagerCategory    Category = "villagers"
+	CustomCategory      Category = "custom"
+	RecipeCategory      Category = "recipes"
+)
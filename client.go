@@ -1,30 +1,253 @@
 package goacnh
 
 import (
-	"os"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"io/fs"
+	"net/http"
+	"time"
 
 	"github.com/go-resty/resty/v2"
+	"golang.org/x/time/rate"
 )
 
 const (
-	baseURL string = "https://acnhapi.com"
+	defaultBaseURL     string = "https://acnhapi.com"
+	defaultConcurrency int    = 4
 )
 
 // Client facilitates interaction with the AC:NH API
 type Client struct {
-	restClient *resty.Client
+	restClient       *resty.Client
+	httpClient       *http.Client
+	transport        http.RoundTripper
+	proxyURL         string
+	tlsConfig        *tls.Config
+	rootCAs          *x509.CertPool
+	baseURL          string
+	assetBaseURL     string
+	nookipediaURL    string
+	assetResolver    AssetResolver
+	timeout          time.Duration
+	downloadTimeout  time.Duration
+	retryCount       int
+	retryWaitTime    time.Duration
+	retryMaxWaitTime time.Duration
+	rateLimiter      *rate.Limiter
+	concurrency      int
+	urlRewriter      URLRewriter
+	dryRun           bool
+	sizeProbing      bool
+	resume           bool
+	downloadFS       DownloadFS
+	skipExisting     bool
+	albumArt         bool
+	fileNamer        FileNamer
+	overwritePolicy  OverwritePolicy
+	offlineData      fs.FS
+	languages        []string
+	hemisphere       Hemisphere
+	recipeProvider   RecipeProvider
+	downloadHooks    []DownloadHook
+	cache            *responseCache
+	diskCache        *diskCache
+	customEntries    customEntries
+	source           Source
+	logger           Logger
+	overrides        Overrides
+	metrics          MetricsHook
+	fieldMask        *fieldMask
+	listFlights      listFlightGroup
+	breaker          *circuitBreaker
+	// sourceOptions names the options that set the client's source, in
+	// order, and nookipediaKeyMissing is set if WithNookipedia was given no
+	// API key, so that NewE can reject them.
+	sourceOptions        []string
+	nookipediaKeyMissing bool
 }
 
-// New creates a new instance of the AC:NH API client
-func New() *Client {
+// New creates a new instance of the AC:NH API client, configured by any given
+// options. Options that NewE would reject are applied as far as they can be,
+// and the problem with them is logged if the client has a logger.
+func New(opts ...Option) *Client {
+	c, err := newClient(opts)
+	if err != nil {
+		c.logf("%v", err)
+	}
+	return c
+}
+
+// NewE is like New, but returns an OptionError instead of a client if the
+// given options conflict or hold invalid values, such as a base URL that is
+// not an HTTP URL, a negative retry count or a concurrency of zero.
+func NewE(opts ...Option) (*Client, error) {
+	c, err := newClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// newClient creates a client configured by the given options, along with the
+// error of validating them.
+func newClient(opts []Option) (*Client, error) {
 	c := Client{
-		restClient: resty.New(),
+		baseURL:       defaultBaseURL,
+		nookipediaURL: defaultNookipediaBaseURL,
+		languages:     []string{defaultLanguageCode},
+		concurrency:   defaultConcurrency,
+		downloadFS:    osFS{},
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	err := c.validate()
+	switch {
+	case c.restClient != nil:
+	case c.httpClient != nil:
+		// The HTTP client is copied, as resty and the options below replace its
+		// transport.
+		httpClient := *c.httpClient
+		c.restClient = resty.NewWithClient(&httpClient)
+	default:
+		c.restClient = resty.New()
+	}
+	if c.transport != nil {
+		c.restClient.SetTransport(c.transport)
 	}
-	c.restClient.SetBaseURL(baseURL)
-	return &c
+	if (c.tlsConfig != nil || c.rootCAs != nil || c.proxyURL != "") && c.ownTransport() {
+		if c.tlsConfig != nil || c.rootCAs != nil {
+			config := &tls.Config{}
+			if c.tlsConfig != nil {
+				config = c.tlsConfig.Clone()
+			}
+			if c.rootCAs != nil {
+				config.RootCAs = c.rootCAs
+			}
+			c.restClient.SetTLSClientConfig(config)
+		}
+		if c.proxyURL != "" {
+			c.restClient.SetProxy(c.proxyURL)
+		}
+	}
+	if c.offlineData != nil {
+		c.restClient.SetTransport(offlineTransport{})
+	}
+	c.useMetricsTransport()
+	if c.breaker != nil {
+		c.breaker.next = c.baseTransport()
+		c.restClient.SetTransport(c.breaker)
+	}
+	if c.diskCache != nil {
+		c.diskCache.client = &c
+		c.diskCache.next = c.baseTransport()
+		c.restClient.SetTransport(c.diskCache)
+	}
+	c.useLoggingTransport()
+	c.restClient.SetBaseURL(c.baseURL)
+	c.restClient.JSONUnmarshal = jsonUnmarshal
+	c.restClient.OnBeforeRequest(requestGzip)
+	if c.retryCount > 0 {
+		c.restClient.
+			SetRetryCount(c.retryCount).
+			SetRetryWaitTime(c.retryWaitTime).
+			SetRetryMaxWaitTime(c.retryMaxWaitTime).
+			AddRetryCondition(retryCondition).
+			AddRetryHook(c.discardRetriedResponse)
+		c.useRetryLogging()
+	}
+	if c.rateLimiter != nil {
+		c.restClient.OnBeforeRequest(c.waitForRateLimit)
+	}
+	if c.urlRewriter != nil {
+		c.restClient.SetPreRequestHook(c.rewriteURL)
+	}
+	return &c, err
+}
+
+// Hemisphere returns the hemisphere the client was configured with. It is
+// NorthernHemisphere unless set by WithHemisphere or WithSystemLocale.
+func (c *Client) Hemisphere() Hemisphere {
+	return c.hemisphere
 }
 
-func dirExists(path string) bool {
-	_, err := os.Stat(path)
-	return !os.IsNotExist(err)
+// waitForRateLimit blocks until the client's rate limit allows another request
+// to be made, or the request's context is done.
+func (c *Client) waitForRateLimit(_ *resty.Client, r *resty.Request) error {
+	return c.rateLimiter.Wait(r.Context())
+}
+
+// withTimeout returns a copy of ctx that is cancelled after the given timeout,
+// or ctx itself if the timeout is not positive.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// ownTransport replaces the client's transport with a copy of it, so that the
+// TLS and proxy settings of a transport that may be shared with others, such as
+// http.DefaultTransport, are not changed. It reports whether the transport could
+// be copied, which only an *http.Transport can, and logs that the options are
+// ignored otherwise.
+func (c *Client) ownTransport() bool {
+	transport, ok := c.baseTransport().(*http.Transport)
+	if !ok {
+		c.logf("ignoring proxy and TLS options for transport of type %T", c.baseTransport())
+		return false
+	}
+	c.restClient.SetTransport(transport.Clone())
+	return true
+}
+
+// baseTransport returns the round tripper the client currently sends requests
+// through, so that it can be wrapped.
+func (c *Client) baseTransport() http.RoundTripper {
+	if transport := c.restClient.GetClient().Transport; transport != nil {
+		return transport
+	}
+	return http.DefaultTransport
+}
+
+// retryCondition reports whether a request should be retried, which it is if
+// IsRetryable accepts its transport error, or the error checkResponse makes of
+// its response, so that deprecation notices are not retried.
+func retryCondition(resp *resty.Response, err error) bool {
+	if err != nil {
+		return IsRetryable(err)
+	}
+	if resp == nil || !IsRetryable(&APIError{StatusCode: resp.StatusCode()}) {
+		return false
+	}
+	if resp.Body() != nil || resp.RawResponse == nil || resp.RawResponse.Body == nil {
+		return IsRetryable(checkResponse(resp))
+	}
+	// The body of a response made with SetDoNotParseResponse is still to be
+	// read by the caller, so checkResponse is given only its start, which is
+	// then put back in front of the rest.
+	raw := resp.RawResponse.Body
+	head, _ := io.ReadAll(io.LimitReader(raw, deprecationBodyLimit))
+	resp.RawResponse.Body = io.NopCloser(bytes.NewReader(head))
+	retry := IsRetryable(checkResponse(resp))
+	resp.RawResponse.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), raw), raw}
+	return retry
+}
+
+// discardRetriedResponse closes the unread body of a response that is about to
+// be retried, so that requests made with SetDoNotParseResponse do not leak a
+// connection for every failed attempt. The last response is left for the
+// caller.
+func (c *Client) discardRetriedResponse(resp *resty.Response, _ error) {
+	if resp == nil || resp.RawResponse == nil || resp.Request.Attempt > c.retryCount {
+		return
+	}
+	io.Copy(io.Discard, resp.RawBody())
+	resp.RawBody().Close()
 }
@@ -0,0 +1,45 @@
+package goacnh
+
+import (
+	"compress/gzip"
+	"io"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// requestGzip asks for JSON responses to be compressed with gzip, as the
+// full catalogues compress well. Images and audio files are requested as they
+// are, since they are already compressed and are written out byte for byte.
+// Setting the header stops the HTTP transport from decompressing responses
+// itself, so responses read without resty parsing them are decompressed by
+// responseBody.
+func requestGzip(_ *resty.Client, r *resty.Request) error {
+	if r.Header.Get("Accept") == "application/json" && r.Header.Get("Accept-Encoding") == "" {
+		r.SetHeader("Accept-Encoding", "gzip")
+	}
+	return nil
+}
+
+// responseBody returns the body of a response made with SetDoNotParseResponse,
+// decompressing it if it was compressed with gzip.
+func responseBody(resp *resty.Response) io.Reader {
+	body := resp.RawBody()
+	if !strings.EqualFold(resp.Header().Get("Content-Encoding"), "gzip") {
+		return body
+	}
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return errReader{err}
+	}
+	return gz
+}
+
+// errReader is a reader that fails with the error it holds.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,52 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ListResult is the outcome of a conditional list request. If NotModified is
+// set, the category has not changed since the given ETag and Body is nil.
+// Otherwise Body holds the raw JSON of the category as provided by the API.
+type ListResult struct {
+	NotModified bool
+	ETag        string
+	Body        []byte
+}
+
+// ListSince fetches the raw JSON of a whole category, sending the given ETag
+// so the API can avoid resending data that has not changed. An empty ETag
+// always fetches the category. This is intended for callers that manage their
+// own storage. An error is returned if the request failed or a status other
+// than 200 or 304 was returned.
+func (c *Client) ListSince(ctx context.Context, category Category, etag string) (*ListResult, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	listEndpoint, ok := listEndpoints[category]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCategory, category)
+	}
+	req := c.restClient.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetPathParam("apiVersion", strconv.Itoa(apiVersion))
+	if etag != "" {
+		req.SetHeader("If-None-Match", etag)
+	}
+	resp, err := req.Get(listEndpoint.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s list: %w", category, err)
+	}
+	if resp.StatusCode() == http.StatusNotModified {
+		return &ListResult{NotModified: true, ETag: etag}, nil
+	}
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+	return &ListResult{
+		ETag: resp.Header().Get("ETag"),
+		Body: resp.Body(),
+	}, nil
+}
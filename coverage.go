@@ -0,0 +1,161 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// coverageAssetSamples is the number of asset URLs checked in each category
+// by CoverageReport.
+const coverageAssetSamples int = 3
+
+// coverageAssetFields are the fields of an entry that hold the URL of an asset.
+var coverageAssetFields = []string{"image_uri", "icon_uri", "music_uri"}
+
+// CoverageReport summarises what the API or Source the client reads from
+// provides, as a quick health audit of it.
+type CoverageReport struct {
+	Categories []*CategoryCoverage
+}
+
+// CategoryCoverage summarises what the API provides for a single category.
+type CategoryCoverage struct {
+	Category Category
+	// Count is the number of entries listed in the category.
+	Count int
+	// Languages lists, in order, the language codes that entries have names
+	// in.
+	Languages []string
+	// AssetsChecked is the number of asset URLs, from the first entries of the
+	// category, whose reachability was checked with a HEAD request, and
+	// AssetsReachable is how many of them responded successfully.
+	AssetsChecked   int
+	AssetsReachable int
+	// Anomalies describes entries that are not shaped as the API documents,
+	// such as those without a name or ID, and IDs used by more than one entry.
+	// IDs are not checked for categories that have none, such as fossils.
+	Anomalies []string
+	// Err is set if the category could not be fetched or decoded.
+	Err error
+}
+
+// CoverageReport fetches every category that can be listed and reports how
+// many entries each has, the languages their names are given in, whether a
+// sample of their images and audio files can be reached, and any entries that
+// are not shaped as expected. A category that fails is reported with its
+// error rather than failing the whole report, so an error is only returned if
+// the context is done.
+func (c *Client) CoverageReport(ctx context.Context) (*CoverageReport, error) {
+	report := &CoverageReport{}
+	for _, category := range listCategories() {
+		report.Categories = append(report.Categories, c.categoryCoverage(ctx, category))
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+func (c *Client) categoryCoverage(ctx context.Context, category Category) *CategoryCoverage {
+	coverage := &CategoryCoverage{Category: category}
+	var data []byte
+	var err error
+	if c.source != nil {
+		data, err = c.source.CategoryJSON(ctx, category)
+	} else {
+		data, err = c.CategoryJSON(ctx, category)
+	}
+	if err != nil {
+		coverage.Err = err
+		return coverage
+	}
+	var entries map[string]json.RawMessage
+	if err := jsonUnmarshal(data, &entries); err != nil {
+		coverage.Err = fmt.Errorf("failed to decode %s list: %w", category, err)
+		return coverage
+	}
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	coverage.Count = len(keys)
+	languages := make(map[string]bool)
+	ids := make(map[string]string)
+	numbered := !listEndpoints[category].unnumbered
+	var assets []string
+	for _, key := range keys {
+		objects, ok := entryObjects(entries[key])
+		if !ok {
+			coverage.Anomalies = append(coverage.Anomalies, fmt.Sprintf("entry %q is not an object", key))
+			continue
+		}
+		for _, object := range objects {
+			var names map[string]string
+			if err := jsonUnmarshal(object["name"], &names); err != nil || len(names) == 0 {
+				coverage.Anomalies = append(coverage.Anomalies, fmt.Sprintf("entry %q has no name", key))
+			}
+			for code := range names {
+				languages[strings.TrimPrefix(code, "name-")] = true
+			}
+			id := string(object["id"])
+			if id == "" {
+				id = string(object["internal-id"])
+			}
+			switch other, seen := ids[id]; {
+			case !numbered:
+			case id == "":
+				coverage.Anomalies = append(coverage.Anomalies, fmt.Sprintf("entry %q has no ID", key))
+			case seen && other != key:
+				coverage.Anomalies = append(coverage.Anomalies, fmt.Sprintf("ID %s is used by entries %q and %q", id, other, key))
+			default:
+				ids[id] = key
+			}
+			for _, field := range coverageAssetFields {
+				var uri string
+				if jsonUnmarshal(object[field], &uri) == nil && uri != "" && len(assets) < coverageAssetSamples {
+					assets = append(assets, uri)
+				}
+			}
+		}
+	}
+	for code := range languages {
+		coverage.Languages = append(coverage.Languages, code)
+	}
+	sort.Strings(coverage.Languages)
+	for _, uri := range assets {
+		coverage.AssetsChecked++
+		if c.assetReachable(ctx, uri) {
+			coverage.AssetsReachable++
+		}
+	}
+	return coverage
+}
+
+// entryObjects returns the objects that make up an entry of a list, which
+// is either a single object or, for items with variants, an array of them.
+func entryObjects(raw json.RawMessage) ([]map[string]json.RawMessage, bool) {
+	var object map[string]json.RawMessage
+	if err := jsonUnmarshal(raw, &object); err == nil {
+		return []map[string]json.RawMessage{object}, true
+	}
+	var objects []map[string]json.RawMessage
+	if err := jsonUnmarshal(raw, &objects); err == nil && len(objects) > 0 {
+		return objects, true
+	}
+	return nil, false
+}
+
+// assetReachable reports whether a HEAD request for an asset URL succeeds.
+func (c *Client) assetReachable(ctx context.Context, uri string) bool {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.restClient.R().
+		SetContext(ctx).
+		Head(c.AssetURL(uri))
+	return err == nil && resp.StatusCode() < http.StatusBadRequest
+}
@@ -0,0 +1,214 @@
+package goacnh
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// defaultCustomIDStart is the first ID given to custom entries by default. It
+// is far above any ID used by the API, so custom entries never collide with
+// official ones, and IDs below it are refused.
+const defaultCustomIDStart int = 1 << 20
+
+// maxIDAttempts is the number of IDs requested from an IDGenerator before
+// giving up on finding one that is not in use.
+const maxIDAttempts int = 1000
+
+// CustomEntry is a user-created entry, such as a fan-made song or a homebrew
+// item, registered with a client so that it can be served alongside the
+// official data. Entries belong to CustomCategory; Kind records the category
+// of official data the entry resembles, such as SongCategory. An entry whose
+// Value is of the type of that category, such as a *Song for SongCategory, is
+// also returned by its list and lookup methods, such as SongList, SongByID
+// and SongByName, after the official entries. Methods that return the raw
+// JSON of the API, such as ListSince, do not include custom entries.
+type CustomEntry struct {
+	ID   int
+	Kind Category
+	// Name holds the localized names of the entry, keyed like those of the API,
+	// e.g. "name-EUen".
+	Name map[string]string
+	// Value holds the entry itself, such as a *Song. If it is a pointer to a
+	// struct with an int ID field that is zero, the field is set to the
+	// entry's ID when the entry is registered.
+	Value interface{}
+}
+
+// IDGenerator gives out IDs for custom entries. A client asks for another ID
+// if it is given one that is already in use.
+type IDGenerator interface {
+	NextID() int
+}
+
+// IDGeneratorFunc allows an ordinary function to be used as an IDGenerator.
+type IDGeneratorFunc func() int
+
+// NextID calls f().
+func (f IDGeneratorFunc) NextID() int {
+	return f()
+}
+
+// SequentialIDs returns an IDGenerator that counts up from start. It is safe
+// for concurrent use.
+func SequentialIDs(start int) IDGenerator {
+	var mu sync.Mutex
+	next := start
+	return IDGeneratorFunc(func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		id := next
+		next++
+		return id
+	})
+}
+
+// WithIDGenerator sets the generator used to give IDs to custom entries. The
+// default counts up from 1048576. IDs below that are reserved for official
+// data, and are skipped if the generator gives them.
+func WithIDGenerator(generator IDGenerator) Option {
+	return func(c *Client) {
+		c.customEntries.generator = generator
+	}
+}
+
+// customEntries holds the custom entries registered with a client.
+type customEntries struct {
+	mu        sync.RWMutex
+	generator IDGenerator
+	byID      map[int]*CustomEntry
+}
+
+// RegisterCustomEntry adds a custom entry to the client. If the entry's ID is
+// zero it is given one by the client's IDGenerator. An error is returned if
+// its ID is below 1048576, where it could collide with official IDs, or is
+// already used by another custom entry, or if no unused ID could be generated.
+func (c *Client) RegisterCustomEntry(entry *CustomEntry) error {
+	custom := &c.customEntries
+	custom.mu.Lock()
+	defer custom.mu.Unlock()
+	if custom.generator == nil {
+		custom.generator = SequentialIDs(defaultCustomIDStart)
+	}
+	if custom.byID == nil {
+		custom.byID = make(map[int]*CustomEntry)
+	}
+	if entry.ID != 0 {
+		if entry.ID < defaultCustomIDStart {
+			return fmt.Errorf("custom entry id %d is reserved for official data, custom ids start at %d", entry.ID, defaultCustomIDStart)
+		}
+		if _, ok := custom.byID[entry.ID]; ok {
+			return fmt.Errorf("custom entry id %d is already in use", entry.ID)
+		}
+		custom.add(entry)
+		return nil
+	}
+	for i := 0; i < maxIDAttempts; i++ {
+		id := custom.generator.NextID()
+		if _, ok := custom.byID[id]; id < defaultCustomIDStart || ok {
+			continue
+		}
+		entry.ID = id
+		custom.add(entry)
+		return nil
+	}
+	return fmt.Errorf("failed to generate an unused custom entry id")
+}
+
+// add stores entry under its ID, setting the ID of its value if it has none.
+// It must be called with the lock held.
+func (custom *customEntries) add(entry *CustomEntry) {
+	value := reflect.ValueOf(entry.Value)
+	if value.Kind() == reflect.Ptr && !value.IsNil() && value.Elem().Kind() == reflect.Struct {
+		if id := value.Elem().FieldByName("ID"); id.IsValid() && id.CanSet() && id.Kind() == reflect.Int && id.Int() == 0 {
+			id.SetInt(int64(entry.ID))
+		}
+	}
+	custom.byID[entry.ID] = entry
+}
+
+// RemoveCustomEntry removes the custom entry with the given ID, if there is
+// one.
+func (c *Client) RemoveCustomEntry(id int) {
+	c.customEntries.mu.Lock()
+	defer c.customEntries.mu.Unlock()
+	delete(c.customEntries.byID, id)
+}
+
+// CustomEntries returns the custom entries registered with the client, ordered
+// by ID. If kinds are given, only entries of those kinds are returned.
+func (c *Client) CustomEntries(kinds ...Category) []*CustomEntry {
+	c.customEntries.mu.RLock()
+	defer c.customEntries.mu.RUnlock()
+	entries := make([]*CustomEntry, 0, len(c.customEntries.byID))
+	for _, entry := range c.customEntries.byID {
+		if len(kinds) == 0 || containsCategory(kinds, entry.Kind) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries
+}
+
+// CustomEntryByID gets the custom entry with the given ID. An error is
+// returned if there is none.
+func (c *Client) CustomEntryByID(id int) (*CustomEntry, error) {
+	c.customEntries.mu.RLock()
+	defer c.customEntries.mu.RUnlock()
+	if entry, ok := c.customEntries.byID[id]; ok {
+		return entry, nil
+	}
+	return nil, ErrNotFound
+}
+
+// CustomEntryByName gets the custom entry whose name, in any of the client's
+// languages, or failing that in any other language, matches the given name,
+// ignoring case. An error is returned if there is none.
+func (c *Client) CustomEntryByName(name string) (*CustomEntry, error) {
+	entries := c.CustomEntries()
+	for _, anyLanguage := range []bool{false, true} {
+		for _, entry := range entries {
+			if c.hasName(entry.Name, name, anyLanguage) {
+				return entry, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// customValues returns the values of the custom entries of the given kind
+// that are of type T, ordered by ID.
+func customValues[T any](c *Client, kind Category) []*T {
+	var values []*T
+	for _, entry := range c.CustomEntries(kind) {
+		if value, ok := entry.Value.(*T); ok && value != nil {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// customValue returns the value of the custom entry of the given kind with the
+// given ID, if there is one and it is of type T.
+func customValue[T any](c *Client, kind Category, id int) (*T, bool) {
+	c.customEntries.mu.RLock()
+	defer c.customEntries.mu.RUnlock()
+	entry, ok := c.customEntries.byID[id]
+	if !ok || entry.Kind != kind {
+		return nil, false
+	}
+	value, ok := entry.Value.(*T)
+	return value, ok && value != nil
+}
+
+func containsCategory(categories []Category, category Category) bool {
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
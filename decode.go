@@ -0,0 +1,103 @@
+package goacnh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// jsonDecoder is the subset of a streaming JSON decoder needed to walk the
+// object-shaped list responses returned by the API.
+type jsonDecoder interface {
+	Decode(v interface{}) error
+	Token() (json.Token, error)
+	More() bool
+}
+
+var decodeBufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// streamList reads a list response body into a pooled buffer and decodes it
+// entry by entry, passing each to yield in turn, avoiding the intermediate map
+// the API's object shape would otherwise require. Only the fields in mask are
+// decoded, unless it is nil. It stops, without an error, as soon as yield
+// returns false.
+func streamList[T any](body io.Reader, mask *fieldMask, yield func(*T) bool) error {
+	return decodePooled(body, func(r io.Reader) error {
+		return decodeObjectEntries(r, mask, func(_ string, value *T) bool {
+			return yield(value)
+		})
+	})
+}
+
+// streamVariantList reads a response body of the form {"key": [{...}, ...]},
+// as used by the item endpoints, passing each item to yield in turn. Each item
+// is built from its key and its variants by build. Only the fields of each
+// variant in mask are decoded, unless it is nil. It stops, without an error,
+// as soon as yield returns false.
+func streamVariantList[T any, V any](body io.Reader, mask *fieldMask, build func(key string, variants []*V) *T, yield func(*T) bool) error {
+	return decodePooled(body, func(r io.Reader) error {
+		return decodeObjectEntries(r, mask, func(key string, variants *[]*V) bool {
+			return yield(build(key, *variants))
+		})
+	})
+}
+
+// collect gathers every entry that stream decodes from body into a slice.
+func collect[T any](body io.Reader, mask *fieldMask, stream func(io.Reader, *fieldMask, func(*T) bool) error) ([]*T, error) {
+	list := make([]*T, 0)
+	err := stream(body, mask, func(value *T) bool {
+		list = append(list, value)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// decodePooled reads body into a pooled buffer and passes it to decode.
+func decodePooled(body io.Reader, decode func(r io.Reader) error) error {
+	buf := decodeBufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer decodeBufferPool.Put(buf)
+	if _, err := buf.ReadFrom(body); err != nil {
+		return err
+	}
+	return decode(buf)
+}
+
+// decodeObjectEntries walks a JSON object, decoding each of its values in turn
+// through mask and passing them to fn along with their key, until fn returns
+// false.
+func decodeObjectEntries[T any](r io.Reader, mask *fieldMask, fn func(key string, value *T) bool) error {
+	dec := newJSONDecoder(r)
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected a JSON object")
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		value := new(T)
+		if err := mask.decode(dec, value); err != nil {
+			return err
+		}
+		if !fn(key, value) {
+			return nil
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
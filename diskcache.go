@@ -0,0 +1,161 @@
+package goacnh
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// diskCacheFileName matches the names of the files the disk cache writes, so
+// that invalidation never removes anything else from the cache directory.
+var diskCacheFileName = regexp.MustCompile(`^[0-9a-f]{64}\.json$`)
+
+// diskCache is a round tripper that stores successful JSON responses as files
+// in a directory and serves later requests for the same URL from them while
+// they are younger than maxAge.
+type diskCache struct {
+	dir    string
+	maxAge time.Duration
+	client *Client
+	next   http.RoundTripper
+}
+
+// WithDiskCache makes the client store the JSON responses it receives as
+// files in the given directory and serve later requests for the same URL from
+// them, so that short-lived processes do not fetch the whole catalogue on
+// every run. Stored responses older than maxAge are fetched again; a max age
+// that is not positive keeps them until InvalidateDiskCache is called. The
+// directory is created if it does not exist. Images and audio files are not
+// cached.
+func WithDiskCache(dir string, maxAge time.Duration) Option {
+	return func(c *Client) {
+		c.diskCache = &diskCache{dir: dir, maxAge: maxAge}
+	}
+}
+
+// InvalidateDiskCache removes every response stored by the client's disk
+// cache, so that the next request for each is sent to the API. It does nothing
+// if the client has no disk cache.
+func (c *Client) InvalidateDiskCache() error {
+	if c.diskCache == nil {
+		return nil
+	}
+	entries, err := os.ReadDir(c.diskCache.dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read disk cache: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !diskCacheFileName.MatchString(entry.Name()) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.diskCache.dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to invalidate disk cache: %w", err)
+		}
+	}
+	return nil
+}
+
+// RoundTrip serves GET requests for JSON from the cache directory when it
+// holds a fresh response for their URL, and stores successful responses
+// otherwise. Conditional requests are always passed on, as their callers
+// manage their own storage.
+func (dc *diskCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Accept") != "application/json" || req.Header.Get("If-None-Match") != "" {
+		return dc.next.RoundTrip(req)
+	}
+	path := dc.path(req)
+	body, ok := dc.load(path)
+	dc.client.observeCache("disk", ok)
+	if ok {
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	resp, err := dc.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	// Responses are stored decompressed, as that is how they are served.
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(resp.Body); err == nil {
+			body, err = io.ReadAll(gz)
+		}
+		resp.Header.Del("Content-Encoding")
+		resp.Uncompressed = true
+	} else {
+		body, err = io.ReadAll(resp.Body)
+	}
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	// A response that cannot be stored is still returned, the next request
+	// simply fetches it again.
+	_ = dc.store(path, body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+func (dc *diskCache) path(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.URL.String()))
+	return filepath.Join(dc.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (dc *diskCache) load(path string) ([]byte, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false
+	}
+	if dc.maxAge > 0 && time.Since(info.ModTime()) > dc.maxAge {
+		return nil, false
+	}
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return body, true
+}
+
+// store writes a response through a temporary file, so that a concurrent
+// reader never sees a partly written one.
+func (dc *diskCache) store(path string, body []byte) error {
+	if err := os.MkdirAll(dc.dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dc.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,356 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	imageFileExtension string = ".png"
+	// partialFileSuffix is added to the name of a file while a client with
+	// resume enabled downloads it.
+	partialFileSuffix string = ".part"
+)
+
+// downloadRequest describes a file to be downloaded from the API.
+type downloadRequest struct {
+	// endpoint and pathParams locate the file. The API version path parameter
+	// is always set.
+	endpoint   endpoint
+	pathParams map[string]string
+	// fileName is the name the file is saved as when downloaded to a
+	// directory.
+	fileName string
+	// description names what is being downloaded in returned errors.
+	description string
+	// audio marks MP3 files, whose content is checked before it is saved.
+	audio bool
+	// coverArt is the image embedded in the file as its cover art, for
+	// clients that embed album art, if it has one.
+	coverArt *downloadRequest
+	// entity is the model being downloaded, or its ID if it was requested by
+	// ID, as passed to download hooks.
+	entity interface{}
+	// newDirectory is set for downloads to a directory that is only created
+	// when files are really downloaded, so that it need not exist in dry-run
+	// mode.
+	newDirectory bool
+	// plan is set for downloads that are only being planned, which are
+	// handled as in dry-run mode.
+	plan bool
+}
+
+// download fetches the file described by req, saving it in the download
+// directory, which must already exist, under the name chosen by the client's
+// FileNamer and following its overwrite policy. Returned is the path of the
+// downloaded file. Clients that skip existing files return the path of one
+// already downloaded straight away. In dry-run mode the path is returned
+// without anything being downloaded.
+func (c *Client) download(ctx context.Context, req downloadRequest, downloadDirectory string) (string, error) {
+	outputFilePath, _, err := c.downloadSized(ctx, req, downloadDirectory)
+	return outputFilePath, err
+}
+
+// downloadSized is like download but also returns the size of the file, which
+// is -1 in dry-run mode.
+func (c *Client) downloadSized(ctx context.Context, req downloadRequest, downloadDirectory string) (string, int64, error) {
+	ctx, cancel := withTimeout(ctx, c.downloadTimeout)
+	defer cancel()
+	dryRun := c.dryRun || req.plan
+	if !(dryRun && req.newDirectory) && !c.dirExists(downloadDirectory) {
+		return "", 0, fmt.Errorf("destination download directory does not exist")
+	}
+	outputFilePath := c.namedPath(req, downloadDirectory)
+	if c.skipExisting && !dryRun {
+		size, done, err := c.downloaded(ctx, req, outputFilePath)
+		if err != nil {
+			return "", 0, err
+		}
+		if done {
+			c.logf("skipped %s, already downloaded to %s", req.description, outputFilePath)
+			return outputFilePath, size, nil
+		}
+	}
+	outputFilePath, err := c.applyOverwritePolicy(req, outputFilePath)
+	if err != nil {
+		return "", 0, err
+	}
+	if dryRun {
+		return outputFilePath, -1, nil
+	}
+	var size int64
+	if c.resume {
+		if c.albumArt && req.coverArt != nil {
+			// Cover art is only embedded as a file is written from its start,
+			// which a resumed download is not.
+			c.logf("saving %s without cover art, as downloads are resumable", req.description)
+		}
+		size, err = c.downloadResumable(ctx, req, outputFilePath)
+	} else {
+		size, err = c.downloadFile(ctx, req, outputFilePath)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	c.logf("downloaded %s to %s (%d bytes)", req.description, outputFilePath, size)
+	c.observeDownload(req.endpoint.path, size)
+	if err := c.runDownloadHooks(outputFilePath, req.entity, size); err != nil {
+		return "", 0, err
+	}
+	return outputFilePath, size, nil
+}
+
+// downloadFile fetches the file described by req straight to the output path,
+// returning its size. If the download turns out to be corrupt, the file is
+// removed and a CorruptDownloadError is returned.
+func (c *Client) downloadFile(ctx context.Context, req downloadRequest, outputFilePath string) (int64, error) {
+	resp, err := c.getAsset(ctx, req, func(r *resty.Request) *resty.Request {
+		return r.SetDoNotParseResponse(true)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	defer resp.RawBody().Close()
+	if err := checkResponse(resp); err != nil {
+		return 0, err
+	}
+	body, err := checkContent(req, resp, true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	file, err := c.downloadFS.OpenFile(outputFilePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	written, err := c.copyDownload(ctx, req, resp, body, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		c.downloadFS.Remove(outputFilePath)
+		if corrupt, ok := err.(*CorruptDownloadError); ok {
+			corrupt.Path = outputFilePath
+		}
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	return written, nil
+}
+
+// copyDownload copies body, the raw body of the response to req, to w as
+// copyVerified does, embedding cover art in it for clients that embed album
+// art. Returned is the number of bytes written to w.
+func (c *Client) copyDownload(ctx context.Context, req downloadRequest, resp *resty.Response, body io.Reader, w io.Writer) (int64, error) {
+	art := c.withCoverArt(ctx, req, w)
+	if art == nil {
+		return copyVerified(w, resp, body, true)
+	}
+	if _, err := copyVerified(art, resp, body, true); err != nil {
+		return art.size, err
+	}
+	return art.size, art.Flush()
+}
+
+// downloadResumable fetches the file described by req to a partial file next
+// to the output path, continuing from what an earlier, interrupted download
+// left in it with a Range request. Once the partial file holds as many bytes
+// as the server said the file has, it is renamed to the output path, and its
+// size is returned. If the server ignores the range, the file is downloaded
+// from the start.
+func (c *Client) downloadResumable(ctx context.Context, req downloadRequest, outputFilePath string) (int64, error) {
+	partialPath := outputFilePath + partialFileSuffix
+	var offset int64
+	if info, err := c.downloadFS.Stat(partialPath); err == nil {
+		offset = info.Size()
+	}
+	resp, err := c.getAsset(ctx, req, func(r *resty.Request) *resty.Request {
+		if offset > 0 {
+			r.SetHeader("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+		return r.SetDoNotParseResponse(true)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	defer resp.RawBody().Close()
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	total := resp.RawResponse.ContentLength
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		offset = 0
+	case http.StatusPartialContent:
+		start, size, ok := parseContentRange(resp.Header().Get("Content-Range"))
+		if !ok || start != offset {
+			return 0, fmt.Errorf("failed to resume %s: unexpected content range %q", req.description, resp.Header().Get("Content-Range"))
+		}
+		flags = os.O_WRONLY | os.O_APPEND
+		total = size
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file either already holds the whole file, or is not a
+		// part of it and is started over.
+		if _, size, ok := parseContentRange(resp.Header().Get("Content-Range")); ok && size == offset {
+			return offset, c.downloadFS.Rename(partialPath, outputFilePath)
+		}
+		if err := c.downloadFS.Remove(partialPath); err != nil {
+			return 0, fmt.Errorf("failed to restart %s: %w", req.description, err)
+		}
+		return c.downloadResumable(ctx, req, outputFilePath)
+	default:
+		return 0, checkResponse(resp)
+	}
+	body, err := checkContent(req, resp, offset == 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	file, err := c.downloadFS.OpenFile(partialPath, flags, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	// Checksums cover the whole file, so they can only be verified when it
+	// is downloaded from the start.
+	written, err := copyVerified(file, resp, body, offset == 0)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	size := offset + written
+	if err == nil && total >= 0 && size != total {
+		err = &CorruptDownloadError{Size: size, ExpectedSize: total}
+	}
+	if corrupt, ok := err.(*CorruptDownloadError); ok {
+		// A download that stopped short is kept to be resumed, but one that
+		// does not match what the server said is started over next time.
+		corrupt.Path = outputFilePath
+		corrupt.Size, corrupt.ExpectedSize = size, total
+		if !corrupt.truncated() {
+			c.downloadFS.Remove(partialPath)
+		}
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s, which can be resumed: %w", req.description, err)
+	}
+	if err := c.downloadFS.Rename(partialPath, outputFilePath); err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	return size, nil
+}
+
+// parseContentRange parses a Content-Range header such as "bytes 100-999/1000"
+// or "bytes */1000", returning the first byte of the range and the size of the
+// whole file, which is -1 if the server did not give it.
+func parseContentRange(header string) (int64, int64, bool) {
+	rest := strings.TrimPrefix(header, "bytes ")
+	if rest == header {
+		return 0, 0, false
+	}
+	span, sizeText, ok := strings.Cut(rest, "/")
+	if !ok {
+		return 0, 0, false
+	}
+	size := int64(-1)
+	if sizeText != "*" {
+		var err error
+		if size, err = strconv.ParseInt(sizeText, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+	if span == "*" {
+		return 0, size, true
+	}
+	startText, _, ok := strings.Cut(span, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(startText, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, size, true
+}
+
+// downloadTo fetches the file described by req, writing its contents to w. A
+// CorruptDownloadError is returned if the download turns out to be corrupt,
+// in which case w has been given an incomplete or broken file.
+func (c *Client) downloadTo(ctx context.Context, req downloadRequest, w io.Writer) error {
+	ctx, cancel := withTimeout(ctx, c.downloadTimeout)
+	defer cancel()
+	resp, err := c.getAsset(ctx, req, func(r *resty.Request) *resty.Request {
+		return r.SetDoNotParseResponse(true)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	defer resp.RawBody().Close()
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+	body, err := checkContent(req, resp, true)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	written, err := c.copyDownload(ctx, req, resp, body, w)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", req.description, err)
+	}
+	c.logf("downloaded %s (%d bytes)", req.description, written)
+	c.observeDownload(req.endpoint.path, written)
+	return c.runDownloadHooks("", req.entity, written)
+}
+
+// getAsset requests the file described by req, with the request adjusted by
+// prepare. If the file's URL has expired, as shown by a 403 or 410 status
+// code, it is resolved again by the client's AssetResolver and requested once
+// more from the new URL. An AssetExpiredError is returned if the URL is still
+// expired, or there is no resolver.
+func (c *Client) getAsset(ctx context.Context, req downloadRequest, prepare func(r *resty.Request) *resty.Request) (*resty.Response, error) {
+	return c.requestAsset(ctx, resty.MethodGet, req, prepare)
+}
+
+// requestAsset is like getAsset but makes a request with the given method.
+func (c *Client) requestAsset(ctx context.Context, method string, req downloadRequest, prepare func(r *resty.Request) *resty.Request) (*resty.Response, error) {
+	var resp *resty.Response
+	var err error
+	withProfileLabels(ctx, req.endpoint.path, fetchPhase, func() {
+		resp, err = prepare(c.restClient.R().
+			SetContext(ctx).
+			SetPathParam("apiVersion", strconv.Itoa(apiVersion)).
+			SetPathParams(req.pathParams)).
+			Execute(method, c.assetPath(req.endpoint))
+	})
+	if err != nil || !assetExpired(resp) {
+		return resp, err
+	}
+	expiredURL := resp.Request.URL
+	if resp.RawResponse != nil {
+		resp.RawBody().Close()
+		expiredURL = resp.RawResponse.Request.URL.String()
+	}
+	if c.assetResolver == nil {
+		return nil, &AssetExpiredError{URL: expiredURL, StatusCode: resp.StatusCode()}
+	}
+	freshURL, err := c.assetResolver.ResolveAsset(ctx, expiredURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve expired asset url: %w", err)
+	}
+	c.logf("resolved expired asset url %s to %s", expiredURL, freshURL)
+	withProfileLabels(ctx, req.endpoint.path, fetchPhase, func() {
+		resp, err = prepare(c.restClient.R().SetContext(ctx)).Execute(method, freshURL)
+	})
+	if err != nil || !assetExpired(resp) {
+		return resp, err
+	}
+	if resp.RawResponse != nil {
+		resp.RawBody().Close()
+	}
+	return nil, &AssetExpiredError{URL: freshURL, StatusCode: resp.StatusCode()}
+}
+
+func assetExpired(resp *resty.Response) bool {
+	return resp.StatusCode() == http.StatusForbidden || resp.StatusCode() == http.StatusGone
+}
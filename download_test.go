@@ -0,0 +1,152 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDownloadResumable(t *testing.T) {
+	const content = "0123456789"
+	// serveRange answers as a server that supports ranges does.
+	serveRange := func(w http.ResponseWriter, r *http.Request) {
+		var start int
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-", &start); err != nil {
+			w.Write([]byte(content))
+			return
+		}
+		if start >= len(content) {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", len(content)))
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(content)-1, len(content)))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write([]byte(content[start:]))
+	}
+	tests := []struct {
+		name    string
+		partial string
+		handler http.HandlerFunc
+		// wantRanges are the Range headers of the requests made.
+		wantRanges []string
+		wantErr    bool
+		// wantPartial is what should be left in the partial file if the
+		// download fails.
+		wantPartial string
+	}{
+		{name: "200 without partial file", handler: serveRange, wantRanges: []string{""}},
+		{
+			name:    "200 ignoring range",
+			partial: "abc",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(content))
+			},
+			wantRanges: []string{"bytes=3-"},
+		},
+		{name: "206 resuming", partial: "01234", handler: serveRange, wantRanges: []string{"bytes=5-"}},
+		{
+			name:    "206 from wrong offset",
+			partial: "012",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Range", "bytes 5-9/10")
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write([]byte(content[5:]))
+			},
+			wantRanges:  []string{"bytes=3-"},
+			wantErr:     true,
+			wantPartial: "012",
+		},
+		{
+			name:    "206 cut short",
+			partial: "01234",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Range", "bytes 5-9/10")
+				w.WriteHeader(http.StatusPartialContent)
+				w.Write([]byte(content[5:8]))
+			},
+			wantRanges:  []string{"bytes=5-"},
+			wantErr:     true,
+			wantPartial: "01234567",
+		},
+		{name: "416 already complete", partial: content, handler: serveRange, wantRanges: []string{"bytes=10-"}},
+		{
+			name:       "416 restarting",
+			partial:    content + "ab",
+			handler:    serveRange,
+			wantRanges: []string{"bytes=12-", ""},
+		},
+		{
+			name:    "416 without size restarting",
+			partial: "abc",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Range") != "" {
+					w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+					return
+				}
+				w.Write([]byte(content))
+			},
+			wantRanges: []string{"bytes=3-", ""},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var ranges []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ranges = append(ranges, r.Header.Get("Range"))
+				test.handler(w, r)
+			}))
+			defer server.Close()
+			c := New(WithBaseURL(server.URL), WithResume(true))
+			outputPath := filepath.Join(t.TempDir(), "1.png")
+			partialPath := outputPath + partialFileSuffix
+			if test.partial != "" {
+				if err := os.WriteFile(partialPath, []byte(test.partial), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			req := downloadRequest{
+				endpoint:    imageEndpoint,
+				pathParams:  map[string]string{"category": string(FishCategory), "resourceID": "1"},
+				description: "image",
+			}
+			size, err := c.downloadResumable(context.Background(), req, outputPath)
+			if fmt.Sprint(ranges) != fmt.Sprint(test.wantRanges) {
+				t.Errorf("requests had ranges %q, want %q", ranges, test.wantRanges)
+			}
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("downloadResumable() succeeded, want an error")
+				}
+				if _, err := os.Stat(outputPath); err == nil {
+					t.Errorf("output file was written by a failed download")
+				}
+				partial, _ := os.ReadFile(partialPath)
+				if string(partial) != test.wantPartial {
+					t.Errorf("partial file holds %q, want %q", partial, test.wantPartial)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("downloadResumable() failed: %v", err)
+			}
+			if size != int64(len(content)) {
+				t.Errorf("downloadResumable() = %d, want %d", size, len(content))
+			}
+			got, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != content {
+				t.Errorf("output file holds %q, want %q", got, content)
+			}
+			if _, err := os.Stat(partialPath); err == nil {
+				t.Errorf("partial file was left behind")
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package goacnh
+
+import "context"
+
+// SongDownloadResult is the outcome of downloading one song of a batch.
+type SongDownloadResult struct {
+	Song *Song
+	// Path is the file the song was downloaded to, if Err is nil.
+	Path string
+	// Size is the size of the file in bytes, or -1 for clients created
+	// with WithDryRun.
+	Size int64
+	Err  error
+}
+
+// BGMDownloadResult is the outcome of downloading one background music track
+// of a batch.
+type BGMDownloadResult struct {
+	Track *BGMTrack
+	// Path is the file the track was downloaded to, if Err is nil.
+	Path string
+	// Size is the size of the file in bytes, or -1 for clients created
+	// with WithDryRun.
+	Size int64
+	Err  error
+}
+
+// DownloadAllSongs lists every song and downloads each as an MP3 file to the
+// given directory, as SongDownload does, making several downloads at once, up
+// to the limit set by WithConcurrency. A result is returned for each song, in
+// the order they are listed, holding the error of any that failed. An error is
+// only returned if the songs could not be listed. Clients created with
+// WithSkipExisting only download the songs that are missing. In dry-run mode
+// the paths are returned without anything being downloaded, although the
+// songs are still listed. See PlanDownloadAllSongs for a summary of what would
+// be downloaded. Use DownloadAllSongsContext to control cancellation and
+// deadlines.
+func (c *Client) DownloadAllSongs(downloadDirectory string) ([]*SongDownloadResult, error) {
+	return c.DownloadAllSongsContext(context.Background(), downloadDirectory)
+}
+
+// DownloadAllSongsContext is like DownloadAllSongs but makes its requests with
+// the given context.
+func (c *Client) DownloadAllSongsContext(ctx context.Context, downloadDirectory string) ([]*SongDownloadResult, error) {
+	songList, err := c.SongListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqs := make([]downloadRequest, len(songList))
+	for i, song := range songList {
+		reqs[i] = songDownloadRequest(song)
+	}
+	results := make([]*SongDownloadResult, len(songList))
+	c.downloadAll(ctx, reqs, downloadDirectory, func(i int, path string, size int64, err error) {
+		results[i] = &SongDownloadResult{Song: songList[i], Path: path, Size: size, Err: err}
+	})
+	return results, nil
+}
+
+// DownloadAllBGM lists every background music track and downloads each as an
+// MP3 file to the given directory, as BGMDownload does, making several
+// downloads at once, up to the limit set by WithConcurrency. A result is
+// returned for each track, in the order they are listed, holding the error of
+// any that failed. An error is only returned if the tracks could not be
+// listed. Clients created with WithSkipExisting only download the tracks that
+// are missing. In dry-run mode the paths are returned without anything being
+// downloaded, although the tracks are still listed. See PlanDownloadAllBGM for
+// a summary of what would be downloaded. Use DownloadAllBGMContext to control
+// cancellation and deadlines.
+func (c *Client) DownloadAllBGM(downloadDirectory string) ([]*BGMDownloadResult, error) {
+	return c.DownloadAllBGMContext(context.Background(), downloadDirectory)
+}
+
+// DownloadAllBGMContext is like DownloadAllBGM but makes its requests with the
+// given context.
+func (c *Client) DownloadAllBGMContext(ctx context.Context, downloadDirectory string) ([]*BGMDownloadResult, error) {
+	bgmList, err := c.BGMListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqs := make([]downloadRequest, len(bgmList))
+	for i, track := range bgmList {
+		reqs[i] = bgmDownloadRequest(track)
+	}
+	results := make([]*BGMDownloadResult, len(bgmList))
+	c.downloadAll(ctx, reqs, downloadDirectory, func(i int, path string, size int64, err error) {
+		results[i] = &BGMDownloadResult{Track: bgmList[i], Path: path, Size: size, Err: err}
+	})
+	return results, nil
+}
+
+// downloadAll downloads each of reqs to the download directory, as download
+// does, several at once, passing the path, size and error of each to result
+// along with its index.
+func (c *Client) downloadAll(ctx context.Context, reqs []downloadRequest, downloadDirectory string, result func(i int, path string, size int64, err error)) {
+	c.concurrently(len(reqs), func(i int) {
+		path, size, err := c.downloadSized(ctx, reqs[i], downloadDirectory)
+		result(i, path, size, err)
+	})
+}
+
+// DownloadPlan describes what a batch download would fetch, without anything
+// having been downloaded.
+type DownloadPlan struct {
+	// Paths are the files that would be written, in the order the files are
+	// listed, following the client's FileNamer and overwrite policy.
+	Paths []string
+	// Bytes is the total size of the files whose size is known, which are
+	// none of them unless the client was created with WithSizeProbing, and
+	// UnknownSizes the number of the rest.
+	Bytes        int64
+	UnknownSizes int
+	// Errors holds the error of each file that could not be planned, such as
+	// one that is already there for clients with the FailIfExists overwrite
+	// policy, by its index in the list. It is nil if every file could be.
+	Errors map[int]error
+}
+
+// Count returns the number of files that would be downloaded.
+func (p *DownloadPlan) Count() int {
+	return len(p.Paths)
+}
+
+// WithSizeProbing makes PlanDownloadAllSongs and PlanDownloadAllBGM find the
+// size of each file they plan with a HEAD request, so that their plans give
+// the number of bytes that would be downloaded. Without it, planning makes no
+// requests besides listing what would be downloaded.
+func WithSizeProbing() Option {
+	return func(c *Client) {
+		c.sizeProbing = true
+	}
+}
+
+// PlanDownloadAllSongs returns the plan of what DownloadAllSongs would
+// download to the given directory, as it would in dry-run mode, whether or not
+// the client was created with WithDryRun. The songs are still listed. An
+// error is only returned if they could not be. Use
+// PlanDownloadAllSongsContext to control cancellation and deadlines.
+func (c *Client) PlanDownloadAllSongs(downloadDirectory string) (*DownloadPlan, error) {
+	return c.PlanDownloadAllSongsContext(context.Background(), downloadDirectory)
+}
+
+// PlanDownloadAllSongsContext is like PlanDownloadAllSongs but makes its
+// requests with the given context.
+func (c *Client) PlanDownloadAllSongsContext(ctx context.Context, downloadDirectory string) (*DownloadPlan, error) {
+	songList, err := c.SongListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqs := make([]downloadRequest, len(songList))
+	for i, song := range songList {
+		reqs[i] = songDownloadRequest(song)
+	}
+	return c.planAll(ctx, reqs, downloadDirectory), nil
+}
+
+// PlanDownloadAllBGM returns the plan of what DownloadAllBGM would download to
+// the given directory, as it would in dry-run mode, whether or not the client
+// was created with WithDryRun. The tracks are still listed. An error is only
+// returned if they could not be. Use PlanDownloadAllBGMContext to control
+// cancellation and deadlines.
+func (c *Client) PlanDownloadAllBGM(downloadDirectory string) (*DownloadPlan, error) {
+	return c.PlanDownloadAllBGMContext(context.Background(), downloadDirectory)
+}
+
+// PlanDownloadAllBGMContext is like PlanDownloadAllBGM but makes its requests
+// with the given context.
+func (c *Client) PlanDownloadAllBGMContext(ctx context.Context, downloadDirectory string) (*DownloadPlan, error) {
+	bgmList, err := c.BGMListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reqs := make([]downloadRequest, len(bgmList))
+	for i, track := range bgmList {
+		reqs[i] = bgmDownloadRequest(track)
+	}
+	return c.planAll(ctx, reqs, downloadDirectory), nil
+}
+
+// planAll plans the download of each of reqs to the download directory, as
+// downloadAll would in dry-run mode, finding the size of each file for clients
+// that probe sizes.
+func (c *Client) planAll(ctx context.Context, reqs []downloadRequest, downloadDirectory string) *DownloadPlan {
+	paths := make([]string, len(reqs))
+	sizes := make([]int64, len(reqs))
+	errs := make([]error, len(reqs))
+	c.concurrently(len(reqs), func(i int) {
+		req := reqs[i]
+		req.plan = true
+		paths[i], sizes[i], errs[i] = c.downloadSized(ctx, req, downloadDirectory)
+		if errs[i] == nil && c.sizeProbing {
+			sizes[i], errs[i] = c.probeSize(ctx, req)
+		}
+	})
+	plan := &DownloadPlan{Paths: make([]string, 0, len(reqs))}
+	for i, err := range errs {
+		switch {
+		case err != nil:
+			if plan.Errors == nil {
+				plan.Errors = make(map[int]error)
+			}
+			plan.Errors[i] = err
+			continue
+		case sizes[i] < 0:
+			plan.UnknownSizes++
+		default:
+			plan.Bytes += sizes[i]
+		}
+		plan.Paths = append(plan.Paths, paths[i])
+	}
+	return plan
+}
+
+// probeSize returns the size the server reports for the file described by
+// req, within the client's download timeout.
+func (c *Client) probeSize(ctx context.Context, req downloadRequest) (int64, error) {
+	ctx, cancel := withTimeout(ctx, c.downloadTimeout)
+	defer cancel()
+	return c.expectedSize(ctx, req)
+}
@@ -0,0 +1,71 @@
+package goacnh
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+)
+
+// DownloadFS is a writable file system that downloads to a directory are saved
+// in, such as an in-memory file system for tests or an adapter for cloud
+// storage. Names are paths as given to the download methods, joined with the
+// file names of downloads by the rules of the current operating system. The
+// flags given to OpenFile are those of os.OpenFile, of which downloads use
+// O_WRONLY with O_CREATE and O_TRUNC, or with O_APPEND to resume.
+type DownloadFS interface {
+	OpenFile(name string, flag int, perm fs.FileMode) (io.WriteCloser, error)
+	Stat(name string) (fs.FileInfo, error)
+	MkdirAll(path string, perm fs.FileMode) error
+	Rename(oldpath, newpath string) error
+	Remove(name string) error
+}
+
+// WithDownloadFS makes downloads to a directory be saved in fsys instead of on
+// the local disk. The paths returned by download methods, and given to
+// download hooks, are then paths within fsys. A nil fsys restores the local
+// disk.
+func WithDownloadFS(fsys DownloadFS) Option {
+	return func(c *Client) {
+		if fsys == nil {
+			fsys = osFS{}
+		}
+		c.downloadFS = fsys
+	}
+}
+
+// osFS is the DownloadFS of the local disk, which is used by default.
+type osFS struct{}
+
+func (osFS) OpenFile(name string, flag int, perm fs.FileMode) (io.WriteCloser, error) {
+	return os.OpenFile(longPath(name), flag, perm)
+}
+
+func (osFS) Stat(name string) (fs.FileInfo, error) {
+	return os.Stat(longPath(name))
+}
+
+func (osFS) MkdirAll(path string, perm fs.FileMode) error {
+	return os.MkdirAll(longPath(path), perm)
+}
+
+func (osFS) Rename(oldpath, newpath string) error {
+	return os.Rename(longPath(oldpath), longPath(newpath))
+}
+
+func (osFS) Remove(name string) error {
+	return os.Remove(longPath(name))
+}
+
+// dirExists reports whether the given download directory exists in the
+// client's DownloadFS.
+func (c *Client) dirExists(path string) bool {
+	_, err := c.downloadFS.Stat(path)
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
+// fileExists reports whether a file exists in the client's DownloadFS.
+func (c *Client) fileExists(path string) bool {
+	_, err := c.downloadFS.Stat(path)
+	return err == nil
+}
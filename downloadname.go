@@ -0,0 +1,162 @@
+package goacnh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrFileExists is returned by downloads to a directory that already holds a
+// file of the same name, for clients with the FailIfExists overwrite policy.
+var ErrFileExists = errors.New("file already exists")
+
+// OverwritePolicy decides what a download to a directory does when a file of
+// the same name is already there.
+type OverwritePolicy int
+
+const (
+	// OverwriteExisting replaces the existing file. This is the default.
+	OverwriteExisting OverwritePolicy = iota
+	// FailIfExists leaves the existing file alone and fails the download with
+	// ErrFileExists.
+	FailIfExists
+	// RenameIfExists leaves the existing file alone and saves the download
+	// under the first free name with a numbered suffix, such as
+	// "fossil (1).png".
+	RenameIfExists
+)
+
+// maxRenameAttempts limits how many numbered suffixes are tried for a download
+// with the RenameIfExists policy.
+const maxRenameAttempts int = 1000
+
+// WithOverwritePolicy sets what downloads to a directory do when a file of the
+// same name is already there. By default it is replaced.
+func WithOverwritePolicy(policy OverwritePolicy) Option {
+	return func(c *Client) {
+		c.overwritePolicy = policy
+	}
+}
+
+// FileNamer chooses the name a download is saved as in its directory. It is
+// given the model being downloaded, or its ID if it was requested by ID, and
+// the name it would be saved as by default, such as "amber.png". The name
+// it returns is sanitized as SanitizeFileName does.
+type FileNamer func(entity interface{}, fileName string) string
+
+// WithFileNamer makes downloads to a directory be saved under the names chosen
+// by namer, instead of those the API gives.
+func WithFileNamer(namer FileNamer) Option {
+	return func(c *Client) {
+		c.fileNamer = namer
+	}
+}
+
+// WithFileNameTemplate makes downloads to a directory be saved under names
+// built from the given template, in which {name} is replaced by the name it
+// would be saved as by default without its extension, {ext} by its extension,
+// such as ".mp3", and {id} by the ID of the model being downloaded, or by the
+// name if it has none. For example, "{id}-{name}{ext}" saves the song with ID
+// 1 as "1-" followed by its default name.
+func WithFileNameTemplate(template string) Option {
+	return WithFileNamer(func(entity interface{}, fileName string) string {
+		ext := filepath.Ext(fileName)
+		name := strings.TrimSuffix(fileName, ext)
+		id := name
+		if entityID, ok := downloadEntityID(entity); ok {
+			id = strconv.Itoa(entityID)
+		}
+		return strings.NewReplacer("{name}", name, "{ext}", ext, "{id}", id).Replace(template)
+	})
+}
+
+// downloadEntityID returns the ID of a model that can be downloaded, or the ID
+// it was requested by.
+func downloadEntityID(entity interface{}) (int, bool) {
+	switch entity := entity.(type) {
+	case int:
+		return entity, true
+	case *Song:
+		return entity.ID, true
+	case *BGMTrack:
+		return entity.ID, true
+	case *Art:
+		return entity.ID, true
+	case *ItemVariant:
+		return entity.InternalID, true
+	}
+	return 0, false
+}
+
+// namedPath returns the path the file described by req is saved to in the
+// download directory, named by the client's FileNamer.
+func (c *Client) namedPath(req downloadRequest, downloadDirectory string) string {
+	fileName := req.fileName
+	if c.fileNamer != nil {
+		fileName = c.fileNamer(req.entity, fileName)
+	}
+	return downloadFilePath(downloadDirectory, fileName)
+}
+
+// applyOverwritePolicy returns the path the file described by req is saved to
+// in place of outputFilePath, following the client's overwrite policy if a
+// file is already there.
+func (c *Client) applyOverwritePolicy(req downloadRequest, outputFilePath string) (string, error) {
+	if c.overwritePolicy == OverwriteExisting || !c.fileExists(outputFilePath) {
+		return outputFilePath, nil
+	}
+	if c.overwritePolicy == FailIfExists {
+		return "", fmt.Errorf("failed to download %s to %s: %w", req.description, outputFilePath, ErrFileExists)
+	}
+	downloadDirectory, fileName := filepath.Split(outputFilePath)
+	ext := filepath.Ext(fileName)
+	stem := strings.TrimSuffix(fileName, ext)
+	for i := 1; i <= maxRenameAttempts; i++ {
+		renamed := downloadFilePath(downloadDirectory, fmt.Sprintf("%s (%d)%s", stem, i, ext))
+		if !c.fileExists(renamed) {
+			return renamed, nil
+		}
+	}
+	return "", fmt.Errorf("failed to download %s: no free file name: %w", req.description, ErrFileExists)
+}
+
+// downloaded reports whether the file described by req is already saved at
+// outputFilePath, in full as far as a HEAD request for it can tell, returning
+// its size if it is. Files the server gives no size for are taken to be
+// complete if they are not empty.
+func (c *Client) downloaded(ctx context.Context, req downloadRequest, outputFilePath string) (int64, bool, error) {
+	info, err := c.downloadFS.Stat(outputFilePath)
+	if err != nil || !info.Mode().IsRegular() || info.Size() == 0 {
+		return 0, false, nil
+	}
+	size, err := c.expectedSize(ctx, req)
+	if err != nil {
+		return 0, false, err
+	}
+	if c.albumArt && req.coverArt != nil {
+		// Embedded cover art makes the file larger than the server's copy.
+		return info.Size(), size < 0 || info.Size() >= size, nil
+	}
+	return info.Size(), size < 0 || size == info.Size(), nil
+}
+
+// expectedSize returns the size the server reports for the file described by
+// req in response to a HEAD request, or -1 if it does not say.
+func (c *Client) expectedSize(ctx context.Context, req downloadRequest) (int64, error) {
+	resp, err := c.requestAsset(ctx, resty.MethodHead, req, func(r *resty.Request) *resty.Request {
+		return r.SetDoNotParseResponse(true)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to check %s: %w", req.description, err)
+	}
+	defer resp.RawBody().Close()
+	if err := checkResponse(resp); err != nil {
+		return 0, err
+	}
+	return resp.RawResponse.ContentLength, nil
+}
@@ -0,0 +1,71 @@
+package goacnh
+
+import "sort"
+
+// apiVersion is the version of the API that requests are made against.
+const apiVersion int = 1
+
+// endpoint describes a path served by the API, so that everything that needs to
+// know about the API's layout works from the same definitions.
+type endpoint struct {
+	// path is the URL path, where {apiVersion} and any other path parameters
+	// in braces are filled in for each request.
+	path string
+	// cacheable is set if responses can be stored and reused.
+	cacheable bool
+	// asset is set if the endpoint serves an image or audio file rather than
+	// JSON.
+	asset bool
+	// versions lists the API versions that provide the endpoint.
+	versions []int
+	// unnumbered is set for lists whose entries have no ID, such as fossils.
+	unnumbered bool
+}
+
+var (
+	bgmListEndpoint         = endpoint{path: "/v{apiVersion}/backgroundmusic", cacheable: true, versions: []int{1}}
+	bgmTrackEndpoint        = endpoint{path: "/v{apiVersion}/backgroundmusic/{trackID}", cacheable: true, versions: []int{1}}
+	bgmFileEndpoint         = endpoint{path: "/v{apiVersion}/hourly/{trackID}", asset: true, versions: []int{1}}
+	songListEndpoint        = endpoint{path: "/v{apiVersion}/songs", cacheable: true, versions: []int{1}}
+	songEndpoint            = endpoint{path: "/v{apiVersion}/songs/{songID}", cacheable: true, versions: []int{1}}
+	songFileEndpoint        = endpoint{path: "/v{apiVersion}/music/{songID}", asset: true, versions: []int{1}}
+	fishListEndpoint        = endpoint{path: "/v{apiVersion}/fish", cacheable: true, versions: []int{1}}
+	fishEndpoint            = endpoint{path: "/v{apiVersion}/fish/{fishID}", cacheable: true, versions: []int{1}}
+	seaCreatureListEndpoint = endpoint{path: "/v{apiVersion}/sea", cacheable: true, versions: []int{1}}
+	seaCreatureEndpoint     = endpoint{path: "/v{apiVersion}/sea/{seaCreatureID}", cacheable: true, versions: []int{1}}
+	fossilListEndpoint      = endpoint{path: "/v{apiVersion}/fossils", cacheable: true, versions: []int{1}, unnumbered: true}
+	artListEndpoint         = endpoint{path: "/v{apiVersion}/art", cacheable: true, versions: []int{1}}
+	artEndpoint             = endpoint{path: "/v{apiVersion}/art/{artID}", cacheable: true, versions: []int{1}}
+	housewareListEndpoint   = endpoint{path: "/v{apiVersion}/houseware", cacheable: true, versions: []int{1}}
+	wallMountedListEndpoint = endpoint{path: "/v{apiVersion}/wallmounted", cacheable: true, versions: []int{1}}
+	miscListEndpoint        = endpoint{path: "/v{apiVersion}/misc", cacheable: true, versions: []int{1}}
+	imageEndpoint           = endpoint{path: "/v{apiVersion}/images/{category}/{resourceID}", asset: true, versions: []int{1}}
+	iconEndpoint            = endpoint{path: "/v{apiVersion}/icons/{category}/{resourceID}", asset: true, versions: []int{1}}
+	fossilImageEndpoint     = endpoint{path: "/v{apiVersion}/images/fossils/{fossilFileName}", asset: true, versions: []int{1}}
+	artImageEndpoint        = endpoint{path: "/v{apiVersion}/images/art/{artFileName}", asset: true, versions: []int{1}}
+)
+
+// listEndpoints maps each category to the endpoint that lists all of it.
+var listEndpoints = map[Category]endpoint{
+	SongCategory:        songListEndpoint,
+	BGMCategory:         bgmListEndpoint,
+	FishCategory:        fishListEndpoint,
+	SeaCreatureCategory: seaCreatureListEndpoint,
+	FossilCategory:      fossilListEndpoint,
+	ArtCategory:         artListEndpoint,
+	HousewareCategory:   housewareListEndpoint,
+	WallMountedCategory: wallMountedListEndpoint,
+	MiscCategory:        miscListEndpoint,
+}
+
+// listCategories returns the categories in listEndpoints, sorted by name.
+func listCategories() []Category {
+	categories := make([]Category, 0, len(listEndpoints))
+	for category := range listEndpoints {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i] < categories[j]
+	})
+	return categories
+}
@@ -0,0 +1,268 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+var (
+	// ErrNotFound is returned when no resource matches a lookup, and matches
+	// an APIError with a 404 status code via errors.Is.
+	ErrNotFound = errors.New("failed to find a match")
+	// ErrRateLimited matches an APIError with a 429 status code via errors.Is.
+	ErrRateLimited = errors.New("too many requests")
+	// ErrInvalidHour is returned when an hour outside of 0-23 is given.
+	ErrInvalidHour = errors.New("invalid hour")
+	// ErrInvalidWeather is returned when a weather other than SunnyWeather,
+	// RainyWeather or SnowyWeather is given.
+	ErrInvalidWeather = errors.New("invalid weather")
+	// ErrUnknownCategory is returned when a category the API does not list is
+	// given.
+	ErrUnknownCategory = errors.New("unknown category")
+)
+
+// apiErrorBodyLimit is the most of a response body that an APIError keeps.
+const apiErrorBodyLimit int = 512
+
+// APIError is returned when the API responds with a non 200 status code. It
+// holds the URL that was requested and the start of the response body, which
+// often explains the failure.
+type APIError struct {
+	StatusCode int
+	URL        string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("received non-200 status code (%d)", e.StatusCode)
+	if e.URL != "" {
+		msg += " from " + e.URL
+	}
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// Is reports whether target is ErrNotFound for a 404 status code, or
+// ErrRateLimited for a 429 status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// BatchError is returned by batch lookups, such as SongsByIDs, when some of
+// their lookups failed. It holds the error of each ID that failed.
+type BatchError struct {
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	ids := make([]int, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	if len(ids) == 1 {
+		return fmt.Sprintf("failed to look up id %d: %v", ids[0], e.Errors[ids[0]])
+	}
+	return fmt.Sprintf("failed to look up %d ids, including %d: %v", len(ids), ids[0], e.Errors[ids[0]])
+}
+
+// Unwrap returns the error of each ID that failed, so that, from Go 1.20,
+// errors.Is and errors.As match any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// ErrUpstreamDeprecated matches any UpstreamDeprecatedError via errors.Is.
+var ErrUpstreamDeprecated = errors.New("upstream API has been deprecated")
+
+// UpstreamDeprecatedError is returned when the API refuses a request because
+// it has been deprecated or shut down. Any guidance the API gave on where to go
+// instead is parsed into its fields, which are left empty when not provided.
+// It wraps the APIError for the response, so that a deprecation notice sent
+// with a 404 status code still matches ErrNotFound.
+type UpstreamDeprecatedError struct {
+	StatusCode      int
+	Message         string
+	SuggestedMirror string
+	Sunset          time.Time
+	Err             *APIError
+}
+
+func (e *UpstreamDeprecatedError) Error() string {
+	msg := fmt.Sprintf("upstream API has been deprecated (%d)", e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.SuggestedMirror != "" {
+		msg += fmt.Sprintf(" (suggested mirror: %s)", e.SuggestedMirror)
+	}
+	return msg
+}
+
+// Is reports whether target is ErrUpstreamDeprecated.
+func (e *UpstreamDeprecatedError) Is(target error) bool {
+	return target == ErrUpstreamDeprecated
+}
+
+func (e *UpstreamDeprecatedError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// checkResponse returns an error if the response has a non 200 status code. If
+// the API signalled that it has been deprecated, this is an
+// UpstreamDeprecatedError, otherwise it is an APIError.
+func checkResponse(resp *resty.Response) error {
+	if resp.StatusCode() == http.StatusOK {
+		return nil
+	}
+	body := resp.Body()
+	if body == nil && resp.RawResponse != nil {
+		body, _ = io.ReadAll(io.LimitReader(responseBody(resp), deprecationBodyLimit))
+	}
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode(),
+		Body:       bodySnippet(body),
+	}
+	if resp.RawResponse != nil && resp.RawResponse.Request != nil {
+		apiErr.URL = resp.RawResponse.Request.URL.String()
+	} else if resp.Request != nil {
+		apiErr.URL = resp.Request.URL
+	}
+	if deprecatedErr := parseDeprecation(resp, body); deprecatedErr != nil {
+		deprecatedErr.Err = apiErr
+		return deprecatedErr
+	}
+	return apiErr
+}
+
+// bodySnippet returns the start of a response body as a single line of text,
+// or an empty string if it is not text.
+func bodySnippet(body []byte) string {
+	if len(body) > apiErrorBodyLimit {
+		body = body[:apiErrorBodyLimit]
+	}
+	snippet := strings.Join(strings.Fields(strings.ToValidUTF8(string(body), "")), " ")
+	for _, r := range snippet {
+		if r < ' ' {
+			return ""
+		}
+	}
+	return snippet
+}
+
+const deprecationBodyLimit int64 = 64 * 1024
+
+var successorLinkPattern = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?(?:successor-version|alternate)"?`)
+
+// parseDeprecation looks for the signs of a deprecated API in a failed
+// response: a 410 status, the Deprecation or Sunset headers (RFC 8594), or a
+// JSON body flagged as deprecated. It returns nil if none are present.
+func parseDeprecation(resp *resty.Response, body []byte) *UpstreamDeprecatedError {
+	var notice struct {
+		Deprecated bool   `json:"deprecated"`
+		Message    string `json:"message"`
+		Mirror     string `json:"mirror"`
+		Sunset     string `json:"sunset"`
+	}
+	_ = json.Unmarshal(body, &notice)
+	header := resp.Header()
+	if resp.StatusCode() != http.StatusGone && header.Get("Deprecation") == "" &&
+		header.Get("Sunset") == "" && !notice.Deprecated && notice.Sunset == "" {
+		return nil
+	}
+	deprecatedErr := &UpstreamDeprecatedError{
+		StatusCode:      resp.StatusCode(),
+		Message:         notice.Message,
+		SuggestedMirror: notice.Mirror,
+	}
+	if deprecatedErr.SuggestedMirror == "" {
+		for _, link := range header.Values("Link") {
+			if match := successorLinkPattern.FindStringSubmatch(link); match != nil {
+				deprecatedErr.SuggestedMirror = match[1]
+				break
+			}
+		}
+	}
+	if sunset, err := http.ParseTime(header.Get("Sunset")); err == nil {
+		deprecatedErr.Sunset = sunset
+	} else if notice.Sunset != "" {
+		for _, layout := range []string{time.RFC3339, "2006-01-02", http.TimeFormat} {
+			if sunset, err := time.Parse(layout, strings.TrimSpace(notice.Sunset)); err == nil {
+				deprecatedErr.Sunset = sunset
+				break
+			}
+		}
+	}
+	return deprecatedErr
+}
+
+// IsNotFound reports whether err was caused by the API not having the
+// requested resource, or no resource matching a lookup. It is equivalent to
+// errors.Is(err, ErrNotFound).
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRateLimited reports whether err was caused by the API rejecting the
+// request due to too many requests being made. It is equivalent to
+// errors.Is(err, ErrRateLimited).
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsRetryable reports whether the request that caused err may succeed if made
+// again. This is true for rate limiting, server side errors, and network
+// errors such as timeouts and dropped connections, which are the errors the
+// client retries itself when created with WithRetry. Errors such as a missing
+// resource or invalid arguments are permanent, as are deprecation notices,
+// cancelled requests and those refused by an open circuit breaker or an
+// offline client. Of the network errors, only timeouts, refused and reset
+// connections and responses cut short are retryable; others, such as failed
+// DNS lookups, unsupported URL schemes and failed TLS verification, come from
+// the client's configuration and are permanent.
+func IsRetryable(err error) bool {
+	if errors.Is(err, ErrUpstreamDeprecated) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrOffline) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
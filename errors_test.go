@@ -0,0 +1,117 @@
+package goacnh
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestParseDeprecation(t *testing.T) {
+	sunset := time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+		body   string
+		want   *UpstreamDeprecatedError
+	}{
+		{name: "not found", status: http.StatusNotFound, body: `{"message":"not found"}`},
+		{name: "server error", status: http.StatusServiceUnavailable},
+		{name: "not deprecated body", status: http.StatusServiceUnavailable, body: `{"deprecated":false,"message":"down"}`},
+		{name: "gone", status: http.StatusGone, want: &UpstreamDeprecatedError{StatusCode: http.StatusGone}},
+		{
+			name:   "deprecation header",
+			status: http.StatusServiceUnavailable,
+			header: http.Header{"Deprecation": {"true"}},
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusServiceUnavailable},
+		},
+		{
+			name:   "sunset header",
+			status: http.StatusNotFound,
+			header: http.Header{"Sunset": {"Fri, 02 Jan 2026 00:00:00 GMT"}},
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusNotFound, Sunset: sunset},
+		},
+		{
+			name:   "successor link",
+			status: http.StatusGone,
+			header: http.Header{"Link": {`<https://example.com/docs>; rel="help"`, `<https://mirror.example.com>; rel="successor-version"`}},
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusGone, SuggestedMirror: "https://mirror.example.com"},
+		},
+		{
+			name:   "alternate link",
+			status: http.StatusGone,
+			header: http.Header{"Link": {`<https://mirror.example.com>;rel=alternate`}},
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusGone, SuggestedMirror: "https://mirror.example.com"},
+		},
+		{
+			name:   "deprecated body",
+			status: http.StatusServiceUnavailable,
+			body:   `{"deprecated":true,"message":"moved","mirror":"https://mirror.example.com","sunset":"2026-01-02"}`,
+			want: &UpstreamDeprecatedError{
+				StatusCode:      http.StatusServiceUnavailable,
+				Message:         "moved",
+				SuggestedMirror: "https://mirror.example.com",
+				Sunset:          sunset,
+			},
+		},
+		{
+			name:   "body mirror preferred to link",
+			status: http.StatusGone,
+			header: http.Header{"Link": {`<https://link.example.com>; rel="successor-version"`}},
+			body:   `{"mirror":"https://mirror.example.com"}`,
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusGone, SuggestedMirror: "https://mirror.example.com"},
+		},
+		{
+			name:   "body sunset only",
+			status: http.StatusNotFound,
+			body:   `{"sunset":"2026-01-02T00:00:00Z"}`,
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusNotFound, Sunset: sunset},
+		},
+		{
+			name:   "header sunset preferred to body",
+			status: http.StatusGone,
+			header: http.Header{"Sunset": {"Fri, 02 Jan 2026 00:00:00 GMT"}},
+			body:   `{"sunset":"2030-01-01"}`,
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusGone, Sunset: sunset},
+		},
+		{
+			name:   "unreadable sunset",
+			status: http.StatusGone,
+			body:   `{"sunset":"soon"}`,
+			want:   &UpstreamDeprecatedError{StatusCode: http.StatusGone},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := test.header
+			if header == nil {
+				header = make(http.Header)
+			}
+			resp := &resty.Response{RawResponse: &http.Response{StatusCode: test.status, Header: header}}
+			got := parseDeprecation(resp, []byte(test.body))
+			if test.want == nil {
+				if got != nil {
+					t.Fatalf("parseDeprecation() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("parseDeprecation() = nil, want %v", test.want)
+			}
+			if got.StatusCode != test.want.StatusCode {
+				t.Errorf("StatusCode = %d, want %d", got.StatusCode, test.want.StatusCode)
+			}
+			if got.Message != test.want.Message {
+				t.Errorf("Message = %q, want %q", got.Message, test.want.Message)
+			}
+			if got.SuggestedMirror != test.want.SuggestedMirror {
+				t.Errorf("SuggestedMirror = %q, want %q", got.SuggestedMirror, test.want.SuggestedMirror)
+			}
+			if !got.Sunset.Equal(test.want.Sunset) {
+				t.Errorf("Sunset = %v, want %v", got.Sunset, test.want.Sunset)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package goacnh
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// WithFieldMask makes the client decode only the given fields of the entries
+// in lists, named as in the API's JSON, such as "id", "name" and "price". For
+// item categories the fields are those of each variant. Other fields are
+// skipped while decoding and left empty, which makes decoding large lists
+// faster and keeps less of them in memory, for programs that never use the
+// rest. Lookups that match on a field, such as by name, need it to be kept.
+// Nested fields, such as those of "availability", are kept or skipped whole.
+func WithFieldMask(fields ...string) Option {
+	return func(c *Client) {
+		if len(fields) == 0 {
+			c.fieldMask = nil
+			return
+		}
+		mask := &fieldMask{fields: make(map[string]bool, len(fields))}
+		for _, field := range fields {
+			mask.fields[field] = true
+		}
+		c.fieldMask = mask
+	}
+}
+
+// fieldMask decodes values through sparse types that only have the masked
+// fields, so that the decoder skips the others without allocating them. A nil
+// mask decodes every field.
+type fieldMask struct {
+	fields map[string]bool
+	// types caches the sparse type of each type decoded.
+	types sync.Map
+}
+
+// decode decodes the next value of dec into v, a pointer, through the sparse
+// type of the value it points to.
+func (m *fieldMask) decode(dec jsonDecoder, v interface{}) error {
+	target := reflect.ValueOf(v).Elem()
+	sparseType := m.sparseType(target.Type())
+	if sparseType == nil {
+		return dec.Decode(v)
+	}
+	sparse := reflect.New(sparseType)
+	if err := dec.Decode(sparse.Interface()); err != nil {
+		return err
+	}
+	copySparse(target, sparse.Elem())
+	return nil
+}
+
+// sparseType returns a struct type with only the masked fields of t, or of the
+// struct t points to or holds a slice of, or nil if there is no mask or t is
+// not struct based.
+func (m *fieldMask) sparseType(t reflect.Type) reflect.Type {
+	if m == nil {
+		return nil
+	}
+	if cached, ok := m.types.Load(t); ok {
+		sparse, _ := cached.(reflect.Type)
+		return sparse
+	}
+	var sparse reflect.Type
+	switch t.Kind() {
+	case reflect.Ptr:
+		if elem := m.sparseType(t.Elem()); elem != nil {
+			sparse = reflect.PtrTo(elem)
+		}
+	case reflect.Slice:
+		if elem := m.sparseType(t.Elem()); elem != nil {
+			sparse = reflect.SliceOf(elem)
+		}
+	case reflect.Struct:
+		fields := make([]reflect.StructField, 0)
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if field.PkgPath == "" && m.fields[name] {
+				fields = append(fields, reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag})
+			}
+		}
+		sparse = reflect.StructOf(fields)
+	}
+	m.types.Store(t, sparse)
+	return sparse
+}
+
+// copySparse copies a value of a sparse type into the value of the full type
+// it was made from.
+func copySparse(dst reflect.Value, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.New(dst.Type().Elem()))
+		copySparse(dst.Elem(), src.Elem())
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			copySparse(dst.Index(i), src.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			dst.FieldByName(src.Type().Field(i).Name).Set(src.Field(i))
+		}
+	}
+}
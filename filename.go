@@ -0,0 +1,111 @@
+package goacnh
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	maxFileNameBytes   int = 255
+	maxFileNameUTF16   int = 255
+	fileNameDefault        = "_"
+	windowsInvalidRune     = `<>:"/\|?*`
+)
+
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// SanitizeFileName makes name safe to use as a single file name on the current
+// operating system. Path separators, control characters and invalid UTF-8 are
+// replaced with underscores, and the name is shortened to fit the file system's
+// limit without splitting a character. On Windows, the characters and device
+// names that Windows reserves are also avoided, as are trailing dots and
+// spaces. Names in any script, such as Japanese or Chinese, are otherwise left
+// intact.
+func SanitizeFileName(name string) string {
+	return sanitizeFileName(name, runtime.GOOS == "windows")
+}
+
+// downloadFilePath joins the download directory and the sanitized file name,
+// shortening the name, but keeping its extension, if the full path would exceed
+// the operating system's path length limit.
+func downloadFilePath(downloadDirectory string, fileName string) string {
+	fileName = SanitizeFileName(fileName)
+	outputFilePath := filepath.Join(downloadDirectory, fileName)
+	if excess := len(outputFilePath) - maxPathLength; excess > 0 {
+		ext := filepath.Ext(fileName)
+		stem := fileName[:len(fileName)-len(ext)]
+		if excess < len(stem) {
+			outputFilePath = filepath.Join(downloadDirectory, truncateBytes(stem, len(stem)-excess)+ext)
+		}
+	}
+	return outputFilePath
+}
+
+func sanitizeFileName(name string, windows bool) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == utf8.RuneError && !strings.HasPrefix(name[i:], string(utf8.RuneError)):
+			b.WriteRune('_')
+		case r == '/' || r == 0 || unicode.IsControl(r):
+			b.WriteRune('_')
+		case windows && strings.ContainsRune(windowsInvalidRune, r):
+			b.WriteRune('_')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	sanitized := b.String()
+	if windows {
+		sanitized = strings.TrimRight(sanitized, ". ")
+		base := sanitized
+		if dot := strings.IndexByte(base, '.'); dot >= 0 {
+			base = base[:dot]
+		}
+		if windowsReservedNames[strings.ToUpper(strings.TrimSpace(base))] {
+			sanitized = "_" + sanitized
+		}
+		sanitized = truncateUTF16(sanitized, maxFileNameUTF16)
+	} else {
+		sanitized = truncateBytes(sanitized, maxFileNameBytes)
+	}
+	if sanitized == "" || sanitized == "." || sanitized == ".." {
+		return fileNameDefault
+	}
+	return sanitized
+}
+
+// truncateBytes shortens s to at most limit bytes on a rune boundary.
+func truncateBytes(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
+
+// truncateUTF16 shortens s to at most limit UTF-16 code units, which is how
+// Windows measures name lengths, on a rune boundary.
+func truncateUTF16(s string, limit int) string {
+	units := 0
+	for i, r := range s {
+		n := len(utf16.Encode([]rune{r}))
+		if units+n > limit {
+			return s[:i]
+		}
+		units += n
+	}
+	return s
+}
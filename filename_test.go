@@ -0,0 +1,148 @@
+package goacnh
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+func TestSanitizeFileName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		windows string
+		posix   string
+	}{
+		{name: "plain", input: "Bubblegum K.K..mp3", windows: "Bubblegum K.K..mp3", posix: "Bubblegum K.K..mp3"},
+		{name: "reserved name", input: "CON", windows: "_CON", posix: "CON"},
+		{name: "reserved name lower case", input: "con", windows: "_con", posix: "con"},
+		{name: "reserved name with extension", input: "NUL.mp3", windows: "_NUL.mp3", posix: "NUL.mp3"},
+		{name: "reserved name with extensions", input: "COM1.tar.gz", windows: "_COM1.tar.gz", posix: "COM1.tar.gz"},
+		{name: "reserved name with trailing space", input: "LPT9 .png", windows: "_LPT9 .png", posix: "LPT9 .png"},
+		{name: "reserved name as prefix", input: "CONSOLE.png", windows: "CONSOLE.png", posix: "CONSOLE.png"},
+		{name: "trailing dots and spaces", input: "song. . ", windows: "song", posix: "song. . "},
+		{name: "only dots", input: "...", windows: "_", posix: "..."},
+		{name: "dot", input: ".", windows: "_", posix: "_"},
+		{name: "dot dot", input: "..", windows: "_", posix: "_"},
+		{name: "empty", input: "", windows: "_", posix: "_"},
+		{name: "path separators", input: `a/b\c`, windows: "a_b_c", posix: `a_b\c`},
+		{name: "windows reserved characters", input: `a<b>c:d"e|f?g*h`, windows: "a_b_c_d_e_f_g_h", posix: `a<b>c:d"e|f?g*h`},
+		{name: "control characters", input: "a\x00b\nc\x7f", windows: "a_b_c_", posix: "a_b_c_"},
+		{name: "invalid utf-8", input: "a\xffb\xc3", windows: "a_b_", posix: "a_b_"},
+		{name: "replacement character", input: "a�b", windows: "a�b", posix: "a�b"},
+		{name: "japanese", input: "けけソング.mp3", windows: "けけソング.mp3", posix: "けけソング.mp3"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sanitizeFileName(test.input, true); got != test.windows {
+				t.Errorf("windows: sanitizeFileName(%q) = %q, want %q", test.input, got, test.windows)
+			}
+			if got := sanitizeFileName(test.input, false); got != test.posix {
+				t.Errorf("posix: sanitizeFileName(%q) = %q, want %q", test.input, got, test.posix)
+			}
+		})
+	}
+}
+
+func TestSanitizeFileNameLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		windows   bool
+		wantRunes int
+	}{
+		{name: "japanese on windows", input: strings.Repeat("あ", 300), windows: true, wantRunes: 255},
+		{name: "japanese on posix", input: strings.Repeat("あ", 300), wantRunes: 85},
+		{name: "chinese on posix with odd remainder", input: "a" + strings.Repeat("中", 300), wantRunes: 85},
+		{name: "surrogate pairs on windows", input: strings.Repeat("😀", 200), windows: true, wantRunes: 127},
+		{name: "surrogate pairs on posix", input: strings.Repeat("😀", 200), wantRunes: 63},
+		{name: "at the windows limit", input: strings.Repeat("あ", 255), windows: true, wantRunes: 255},
+		{name: "at the posix limit", input: strings.Repeat("a", 255), wantRunes: 255},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := sanitizeFileName(test.input, test.windows)
+			if !utf8.ValidString(got) {
+				t.Fatalf("sanitizeFileName split a rune: %q", got)
+			}
+			if !strings.HasPrefix(test.input, got) {
+				t.Errorf("sanitizeFileName(%q) = %q, which is not a prefix of it", test.input, got)
+			}
+			if n := utf8.RuneCountInString(got); n != test.wantRunes {
+				t.Errorf("sanitizeFileName kept %d runes, want %d", n, test.wantRunes)
+			}
+			if test.windows {
+				if n := len(utf16.Encode([]rune(got))); n > maxFileNameUTF16 {
+					t.Errorf("sanitizeFileName result is %d UTF-16 units, want at most %d", n, maxFileNameUTF16)
+				}
+			} else if len(got) > maxFileNameBytes {
+				t.Errorf("sanitizeFileName result is %d bytes, want at most %d", len(got), maxFileNameBytes)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		limit int
+		bytes string
+		utf16 string
+	}{
+		{name: "ascii", input: "abcdef", limit: 3, bytes: "abc", utf16: "abc"},
+		{name: "short", input: "ab", limit: 3, bytes: "ab", utf16: "ab"},
+		{name: "inside a rune", input: "aあ", limit: 2, bytes: "a", utf16: "aあ"},
+		{name: "inside a surrogate pair", input: "a😀", limit: 2, bytes: "a", utf16: "a"},
+		{name: "zero", input: "あ", limit: 0, bytes: "", utf16: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := truncateBytes(test.input, test.limit); got != test.bytes {
+				t.Errorf("truncateBytes(%q, %d) = %q, want %q", test.input, test.limit, got, test.bytes)
+			}
+			if got := truncateUTF16(test.input, test.limit); got != test.utf16 {
+				t.Errorf("truncateUTF16(%q, %d) = %q, want %q", test.input, test.limit, got, test.utf16)
+			}
+		})
+	}
+}
+
+func TestDownloadFilePath(t *testing.T) {
+	shortDirectory := filepath.Join("downloads", "songs")
+	// A directory that leaves room for only a few bytes of the file name.
+	longDirectory := strings.Repeat("d"+string(filepath.Separator), maxPathLength/2)
+	longDirectory = longDirectory[:maxPathLength-21]
+	tests := []struct {
+		name      string
+		directory string
+		fileName  string
+		want      string
+	}{
+		{name: "short", directory: shortDirectory, fileName: "song.mp3", want: filepath.Join(shortDirectory, "song.mp3")},
+		{name: "sanitized", directory: shortDirectory, fileName: "a/b.mp3", want: filepath.Join(shortDirectory, "a_b.mp3")},
+		{name: "shortened", directory: longDirectory, fileName: strings.Repeat("あ", 20) + ".mp3"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := downloadFilePath(test.directory, test.fileName)
+			if test.want != "" {
+				if got != test.want {
+					t.Errorf("downloadFilePath(%q, %q) = %q, want %q", test.directory, test.fileName, got, test.want)
+				}
+				return
+			}
+			if len(got) > maxPathLength {
+				t.Errorf("downloadFilePath result is %d bytes, want at most %d", len(got), maxPathLength)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("downloadFilePath split a rune: %q", got[len(test.directory):])
+			}
+			if !strings.HasSuffix(got, ".mp3") {
+				t.Errorf("downloadFilePath dropped the extension: %q", got[len(test.directory):])
+			}
+		})
+	}
+}
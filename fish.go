@@ -0,0 +1,85 @@
+package goacnh
+
+import "context"
+
+// Fish represents a catchable fish as represented via the API
+type Fish struct {
+	ID           int               `json:"id"`
+	FileName     string            `json:"file-name"`
+	Name         map[string]string `json:"name"`
+	Availability Availability      `json:"availability"`
+	Shadow       string            `json:"shadow"`
+	Price        int               `json:"price"`
+	PriceCJ      int               `json:"price-cj"`
+	CatchPhrase  string            `json:"catch-phrase"`
+	MuseumPhrase string            `json:"museum-phrase"`
+	ImageURI     string            `json:"image_uri"`
+	IconURI      string            `json:"icon_uri"`
+}
+
+var fishResource = &resource[Fish]{
+	category:        FishCategory,
+	description:     "fish",
+	listDescription: "fish list",
+	list:            fishListEndpoint,
+	stream:          streamList[Fish],
+	item:            fishEndpoint,
+	idParam:         "fishID",
+	id:              func(f *Fish) int { return f.ID },
+	names:           func(f *Fish) []map[string]string { return []map[string]string{f.Name} },
+}
+
+// FishList returns all the fish that the API provides. An error is returned if
+// the request failed or a non 200 error code was returned. Use FishListContext
+// to control cancellation and deadlines.
+func (c *Client) FishList() ([]*Fish, error) {
+	return c.FishListContext(context.Background())
+}
+
+// FishListContext is like FishList but makes its requests with the given
+// context.
+func (c *Client) FishListContext(ctx context.Context) ([]*Fish, error) {
+	return fishResource.listAll(ctx, c)
+}
+
+// FishByID gets a single fish based on the ID provided. An error is returned if
+// the request failed or a non 200 error code was returned. Use FishByIDContext
+// to control cancellation and deadlines.
+func (c *Client) FishByID(id int) (*Fish, error) {
+	return c.FishByIDContext(context.Background(), id)
+}
+
+// FishByIDContext is like FishByID but makes its requests with the given
+// context.
+func (c *Client) FishByIDContext(ctx context.Context, id int) (*Fish, error) {
+	return fishResource.byID(ctx, c, id)
+}
+
+// FishByIDs gets the fish with each of the given IDs, making several requests
+// at once, up to the limit set by WithConcurrency. They are returned in the
+// order of their IDs. If any could not be got, the rest are still returned,
+// with nil in place of those that failed, along with a *BatchError holding the
+// error of each. Use FishByIDsContext to control cancellation and deadlines.
+func (c *Client) FishByIDs(ids []int) ([]*Fish, error) {
+	return c.FishByIDsContext(context.Background(), ids)
+}
+
+// FishByIDsContext is like FishByIDs but makes its requests with the given
+// context.
+func (c *Client) FishByIDsContext(ctx context.Context, ids []int) ([]*Fish, error) {
+	return fishResource.byIDs(ctx, c, ids)
+}
+
+// FishByName get a fish based on its name. The name is compared with its names
+// in each of the client's languages, and then in any other language. An error
+// is returned if the request failed or a non 200 error code was returned or no
+// match was found. Use FishByNameContext to control cancellation and deadlines.
+func (c *Client) FishByName(name string) (*Fish, error) {
+	return c.FishByNameContext(context.Background(), name)
+}
+
+// FishByNameContext is like FishByName but makes its requests with the given
+// context.
+func (c *Client) FishByNameContext(ctx context.Context, name string) (*Fish, error) {
+	return fishResource.byName(ctx, c, name)
+}
@@ -0,0 +1,101 @@
+package goacnh
+
+import "context"
+
+// Fossil represents a single fossil, or fossil part, as represented via the
+// API. Parts of a multi-part fossil share the same PartOf value, which names
+// the complete fossil they belong to.
+type Fossil struct {
+	FileName     string            `json:"file-name"`
+	Name         map[string]string `json:"name"`
+	Price        int               `json:"price"`
+	MuseumPhrase string            `json:"museum-phrase"`
+	ImageURI     string            `json:"image_uri"`
+	PartOf       string            `json:"part-of"`
+}
+
+var fossilResource = &resource[Fossil]{
+	category:        FossilCategory,
+	description:     "fossil",
+	listDescription: "fossil list",
+	list:            fossilListEndpoint,
+	stream:          streamList[Fossil],
+	names:           func(f *Fossil) []map[string]string { return []map[string]string{f.Name} },
+}
+
+// FossilList returns all the fossils that the API provides. An error is
+// returned if the request failed or a non 200 error code was returned. Use
+// FossilListContext to control cancellation and deadlines.
+func (c *Client) FossilList() ([]*Fossil, error) {
+	return c.FossilListContext(context.Background())
+}
+
+// FossilListContext is like FossilList but makes its requests with the given
+// context.
+func (c *Client) FossilListContext(ctx context.Context) ([]*Fossil, error) {
+	return fossilResource.listAll(ctx, c)
+}
+
+// FossilByName get a fossil based on its name. The name is compared with its
+// names in each of the client's languages, and then in any other language. An
+// error is returned if the request failed or a non 200 error code was returned
+// or no match was found. Use FossilByNameContext to control cancellation and
+// deadlines.
+func (c *Client) FossilByName(name string) (*Fossil, error) {
+	return c.FossilByNameContext(context.Background(), name)
+}
+
+// FossilByNameContext is like FossilByName but makes its requests with the
+// given context.
+func (c *Client) FossilByNameContext(ctx context.Context, name string) (*Fossil, error) {
+	return fossilResource.byName(ctx, c, name)
+}
+
+// FossilsByGroup gets all the parts that make up the complete fossil named by
+// group, as given in the part-of field of each part. An error is returned if
+// the request failed or a non 200 error code was returned or no match was
+// found. Use FossilsByGroupContext to control cancellation and deadlines.
+func (c *Client) FossilsByGroup(group string) ([]*Fossil, error) {
+	return c.FossilsByGroupContext(context.Background(), group)
+}
+
+// FossilsByGroupContext is like FossilsByGroup but makes its requests with the
+// given context.
+func (c *Client) FossilsByGroupContext(ctx context.Context, group string) ([]*Fossil, error) {
+	fossilList, err := c.FossilListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	matchedList := make([]*Fossil, 0)
+	for _, fossil := range fossilList {
+		if fossil.PartOf == group {
+			matchedList = append(matchedList, fossil)
+		}
+	}
+	if len(matchedList) == 0 {
+		return nil, ErrNotFound
+	}
+	return matchedList, nil
+}
+
+// FossilGroups returns every fossil the API provides, grouped into complete
+// fossil sets keyed by their part-of value. An error is returned if the request
+// failed or a non 200 error code was returned. Use FossilGroupsContext to
+// control cancellation and deadlines.
+func (c *Client) FossilGroups() (map[string][]*Fossil, error) {
+	return c.FossilGroupsContext(context.Background())
+}
+
+// FossilGroupsContext is like FossilGroups but makes its requests with the
+// given context.
+func (c *Client) FossilGroupsContext(ctx context.Context) (map[string][]*Fossil, error) {
+	fossilList, err := c.FossilListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[string][]*Fossil)
+	for _, fossil := range fossilList {
+		groups[fossil.PartOf] = append(groups[fossil.PartOf], fossil)
+	}
+	return groups, nil
+}
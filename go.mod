@@ -2,6 +2,10 @@ module github.com/willfantom/go-acnh
 
 go 1.18
 
-require github.com/go-resty/resty/v2 v2.7.0
+require (
+	github.com/go-resty/resty/v2 v2.7.0
+	github.com/goccy/go-json v0.10.2
+	golang.org/x/time v0.3.0
+)
 
-require golang.org/x/net v0.0.0-20211029224645-99673261e6eb // indirect
+require golang.org/x/net v0.0.0-20220225172249-27dd8689420f // indirect
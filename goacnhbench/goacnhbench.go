@@ -0,0 +1,158 @@
+// Package goacnhbench provides benchmarks of the phases of a goacnh client's
+// work, fetching, decoding and indexing, which can be run against any client
+// or source, so that users and contributors can compare performance across
+// versions, options and sources. Each benchmark is a function to call from a
+// testing benchmark, such as:
+//
+//	func BenchmarkFishByName(b *testing.B) {
+//		c := goacnh.New(goacnh.WithCache(time.Hour))
+//		goacnhbench.BenchmarkFishByName(b, c, "bitterling")
+//	}
+package goacnhbench
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	goacnh "github.com/willfantom/go-acnh"
+)
+
+// DefaultFishCount is the number of fish in the list made by FishListJSON for
+// benchmarks, which is about as many as the API serves.
+const DefaultFishCount int = 80
+
+// FishListJSON returns a fish list body of the form the API serves, holding
+// the given number of fish. The fish with ID i is named "fish i" in English.
+func FishListJSON(count int) ([]byte, error) {
+	list := make(map[string]*goacnh.Fish, count)
+	for i := 1; i <= count; i++ {
+		fileName := fmt.Sprintf("fish_%d", i)
+		list[fileName] = &goacnh.Fish{
+			ID:       i,
+			FileName: fileName,
+			Name: map[string]string{
+				"name-USen": fmt.Sprintf("fish %d", i),
+				"name-EUen": fmt.Sprintf("fish %d", i),
+				"name-JPja": fmt.Sprintf("さかな %d", i),
+			},
+			Availability: goacnh.Availability{
+				MonthNorthern: "1-12",
+				MonthSouthern: "1-12",
+				Location:      "River",
+				Rarity:        "Common",
+			},
+			Shadow:       "Small (2)",
+			Price:        100 * i,
+			PriceCJ:      150 * i,
+			CatchPhrase:  "I caught a fish! Not bad!",
+			MuseumPhrase: "Fish are fascinating creatures.",
+			ImageURI:     fmt.Sprintf("https://acnhapi.com/v1/images/fish/%d", i),
+			IconURI:      fmt.Sprintf("https://acnhapi.com/v1/icons/fish/%d", i),
+		}
+	}
+	return json.Marshal(list)
+}
+
+// NewServer starts a server that answers every request with the given JSON
+// body, so that a client given its URL with goacnh.WithBaseURL can be
+// benchmarked without measuring the network. The caller should close it.
+func NewServer(body []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+}
+
+// StaticSource returns a source that returns the given body for every
+// category, so that a client given it with goacnh.WithSource spends no time
+// fetching.
+func StaticSource(body []byte) goacnh.Source {
+	return staticSource(body)
+}
+
+type staticSource []byte
+
+func (s staticSource) CategoryJSON(context.Context, goacnh.Category) ([]byte, error) {
+	return s, nil
+}
+
+// BenchmarkFetch measures fetching the raw list of the given category from
+// source, which may be a *goacnh.Client, reporting the bytes fetched.
+func BenchmarkFetch(b *testing.B, source goacnh.Source, category goacnh.Category) {
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := source.CategoryJSON(ctx, category)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.SetBytes(int64(len(data)))
+	}
+}
+
+// BenchmarkDecode measures decoding the given fish list body, as made by
+// FishListJSON, by a client created with the given options and a source that
+// serves body.
+func BenchmarkDecode(b *testing.B, body []byte, opts ...goacnh.Option) {
+	opts = append(opts[:len(opts):len(opts)], goacnh.WithSource(StaticSource(body)))
+	c, err := goacnh.NewE(opts...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	ctx := context.Background()
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.FishListContext(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkFishList measures getting the fish list with c, fetching and
+// decoding it unless c caches it.
+func BenchmarkFishList(b *testing.B, c *goacnh.Client) {
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.FishListContext(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkFishByID measures looking up the fish with the given ID with c.
+func BenchmarkFishByID(b *testing.B, c *goacnh.Client, id int) {
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.FishByIDContext(ctx, id); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkFishByName measures looking up the fish with the given name with c.
+// The list is fetched once before timing begins, so with goacnh.WithCache only
+// the search of the list is measured.
+func BenchmarkFishByName(b *testing.B, c *goacnh.Client, name string) {
+	ctx := context.Background()
+	if _, err := c.FishByNameContext(ctx, name); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.FishByNameContext(ctx, name); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
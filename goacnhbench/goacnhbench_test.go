@@ -0,0 +1,46 @@
+package goacnhbench
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	goacnh "github.com/willfantom/go-acnh"
+)
+
+func benchmarkBody(b *testing.B) []byte {
+	b.Helper()
+	body, err := FishListJSON(DefaultFishCount)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return body
+}
+
+func BenchmarkFetchList(b *testing.B) {
+	server := NewServer(benchmarkBody(b))
+	defer server.Close()
+	BenchmarkFetch(b, goacnh.New(goacnh.WithBaseURL(server.URL)), goacnh.FishCategory)
+}
+
+func BenchmarkDecodeList(b *testing.B) {
+	BenchmarkDecode(b, benchmarkBody(b))
+}
+
+func BenchmarkList(b *testing.B) {
+	server := NewServer(benchmarkBody(b))
+	defer server.Close()
+	BenchmarkFishList(b, goacnh.New(goacnh.WithBaseURL(server.URL)))
+}
+
+func BenchmarkIndexByID(b *testing.B) {
+	c := goacnh.New(goacnh.WithSource(StaticSource(benchmarkBody(b))))
+	BenchmarkFishByID(b, c, DefaultFishCount)
+}
+
+func BenchmarkIndexByName(b *testing.B) {
+	server := NewServer(benchmarkBody(b))
+	defer server.Close()
+	c := goacnh.New(goacnh.WithBaseURL(server.URL), goacnh.WithCache(time.Hour))
+	BenchmarkFishByName(b, c, fmt.Sprintf("Fish %d", DefaultFishCount))
+}
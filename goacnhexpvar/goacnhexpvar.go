@@ -0,0 +1,99 @@
+// Package goacnhexpvar publishes the requests, downloads and cache lookups
+// made by a goacnh client as expvar variables, which programs that serve
+// expvar's handler show at /debug/vars.
+package goacnhexpvar
+
+import (
+	"expvar"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Metrics is a goacnh.MetricsHook that records what a client does in an
+// expvar map holding:
+//
+//   - requests, counting requests by endpoint and then status code, which is
+//     "error" for requests that got no response
+//   - request_seconds, the total latency of requests by endpoint
+//   - downloaded_bytes, counting bytes downloaded by endpoint
+//   - cache_lookups, counting cache lookups by cache and then result, which is
+//     "hit" or "miss"
+type Metrics struct {
+	// mu guards the creation of the nested maps.
+	mu              sync.Mutex
+	requests        *expvar.Map
+	requestSeconds  *expvar.Map
+	downloadedBytes *expvar.Map
+	cacheLookups    *expvar.Map
+}
+
+// publishMu guards checking that a name is unpublished and publishing under
+// it, so that concurrent calls to New with the same name cannot both pass the
+// check and make expvar.Publish panic.
+var publishMu sync.Mutex
+
+// New creates Metrics and publishes them under the given name, such as
+// "goacnh". An error is returned if a variable of that name has already been
+// published, as each name can only be published once.
+func New(name string) (*Metrics, error) {
+	publishMu.Lock()
+	defer publishMu.Unlock()
+	if expvar.Get(name) != nil {
+		return nil, fmt.Errorf("expvar %q is already published", name)
+	}
+	m := &Metrics{
+		requests:        new(expvar.Map).Init(),
+		requestSeconds:  new(expvar.Map).Init(),
+		downloadedBytes: new(expvar.Map).Init(),
+		cacheLookups:    new(expvar.Map).Init(),
+	}
+	vars := new(expvar.Map).Init()
+	vars.Set("requests", m.requests)
+	vars.Set("request_seconds", m.requestSeconds)
+	vars.Set("downloaded_bytes", m.downloadedBytes)
+	vars.Set("cache_lookups", m.cacheLookups)
+	expvar.Publish(name, vars)
+	return m, nil
+}
+
+// ObserveRequest implements goacnh.MetricsHook.
+func (m *Metrics) ObserveRequest(endpoint string, statusCode int, duration time.Duration, err error) {
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(statusCode)
+	}
+	m.child(m.requests, endpoint).Add(code, 1)
+	m.requestSeconds.AddFloat(endpoint, duration.Seconds())
+}
+
+// ObserveDownload implements goacnh.MetricsHook.
+func (m *Metrics) ObserveDownload(endpoint string, bytes int64) {
+	m.downloadedBytes.Add(endpoint, bytes)
+}
+
+// ObserveCache implements goacnh.MetricsHook.
+func (m *Metrics) ObserveCache(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.child(m.cacheLookups, cache).Add(result, 1)
+}
+
+// child returns the map held by parent under key, creating it if there is
+// none.
+func (m *Metrics) child(parent *expvar.Map, key string) *expvar.Map {
+	if child, ok := parent.Get(key).(*expvar.Map); ok {
+		return child
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if child, ok := parent.Get(key).(*expvar.Map); ok {
+		return child
+	}
+	child := new(expvar.Map).Init()
+	parent.Set(key, child)
+	return child
+}
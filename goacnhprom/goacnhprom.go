@@ -0,0 +1,83 @@
+// Package goacnhprom reports the requests, downloads and cache lookups made
+// by a goacnh client as Prometheus metrics. It is a module of its own, so that
+// programs that do not use it do not depend on the Prometheus client.
+package goacnhprom
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics is a goacnh.MetricsHook that records what a client does as
+// Prometheus metrics:
+//
+//   - goacnh_requests_total, counting requests by endpoint and status code,
+//     which is "error" for requests that got no response
+//   - goacnh_request_duration_seconds, a histogram of request latency by
+//     endpoint
+//   - goacnh_downloaded_bytes_total, counting bytes downloaded by endpoint
+//   - goacnh_cache_lookups_total, counting cache lookups by cache and result,
+//     which is "hit" or "miss"
+type Metrics struct {
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	downloadedBytes *prometheus.CounterVec
+	cacheLookups    *prometheus.CounterVec
+}
+
+// New creates Metrics and registers them with the given registerer, such as
+// prometheus.DefaultRegisterer. An error is returned if they could not be
+// registered, such as when they already have been.
+func New(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "goacnh_requests_total",
+			Help: "Requests sent to the AC:NH API, by endpoint and status code.",
+		}, []string{"endpoint", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "goacnh_request_duration_seconds",
+			Help:    "Latency of requests sent to the AC:NH API, by endpoint.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+		downloadedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "goacnh_downloaded_bytes_total",
+			Help: "Bytes downloaded from the AC:NH API, by endpoint.",
+		}, []string{"endpoint"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "goacnh_cache_lookups_total",
+			Help: "Lookups in the goacnh caches, by cache and result.",
+		}, []string{"cache", "result"}),
+	}
+	for _, collector := range []prometheus.Collector{m.requests, m.requestDuration, m.downloadedBytes, m.cacheLookups} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ObserveRequest implements goacnh.MetricsHook.
+func (m *Metrics) ObserveRequest(endpoint string, statusCode int, duration time.Duration, err error) {
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(statusCode)
+	}
+	m.requests.WithLabelValues(endpoint, code).Inc()
+	m.requestDuration.WithLabelValues(endpoint).Observe(duration.Seconds())
+}
+
+// ObserveDownload implements goacnh.MetricsHook.
+func (m *Metrics) ObserveDownload(endpoint string, bytes int64) {
+	m.downloadedBytes.WithLabelValues(endpoint).Add(float64(bytes))
+}
+
+// ObserveCache implements goacnh.MetricsHook.
+func (m *Metrics) ObserveCache(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(cache, result).Inc()
+}
@@ -0,0 +1,38 @@
+package goacnh
+
+import "fmt"
+
+// DownloadHook is called after each successful download, so that further steps
+// such as transcoding, uploading or tagging can be chained on. It is given the
+// path of the downloaded file, which is empty if the download was written to
+// an io.Writer, the model that was downloaded, or its ID if it was requested by
+// ID, and the number of bytes downloaded. An error returned by the hook is
+// returned by the download method.
+type DownloadHook interface {
+	AfterDownload(path string, entity interface{}, bytes int64) error
+}
+
+// DownloadHookFunc allows an ordinary function to be used as a DownloadHook.
+type DownloadHookFunc func(path string, entity interface{}, bytes int64) error
+
+// AfterDownload calls f(path, entity, bytes).
+func (f DownloadHookFunc) AfterDownload(path string, entity interface{}, bytes int64) error {
+	return f(path, entity, bytes)
+}
+
+// WithDownloadHook adds hooks to be called, in the order given, after each
+// successful download. It can be given multiple times.
+func WithDownloadHook(hooks ...DownloadHook) Option {
+	return func(c *Client) {
+		c.downloadHooks = append(c.downloadHooks, hooks...)
+	}
+}
+
+func (c *Client) runDownloadHooks(path string, entity interface{}, bytes int64) error {
+	for _, hook := range c.downloadHooks {
+		if err := hook.AfterDownload(path, entity, bytes); err != nil {
+			return fmt.Errorf("download hook failed: %w", err)
+		}
+	}
+	return nil
+}
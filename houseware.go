@@ -0,0 +1,77 @@
+package goacnh
+
+import "context"
+
+// HousewareItem represents a piece of houseware furniture as represented via
+// the API. Unlike other resources, the API provides each item as a list of its
+// variants, which all share the same name.
+type HousewareItem struct {
+	Name     string
+	Variants []*ItemVariant
+}
+
+func newHousewareItem(name string, variants []*ItemVariant) *HousewareItem {
+	return &HousewareItem{Name: name, Variants: variants}
+}
+
+var housewareResource = &resource[HousewareItem]{
+	category:        HousewareCategory,
+	description:     "houseware item",
+	listDescription: "houseware list",
+	list:            housewareListEndpoint,
+	stream:          variantStream(newHousewareItem),
+	names:           func(item *HousewareItem) []map[string]string { return variantNames(item.Variants) },
+}
+
+// HousewareList returns all the houseware items that the API provides. An error
+// is returned if the request failed or a non 200 error code was returned. Use
+// HousewareListContext to control cancellation and deadlines.
+func (c *Client) HousewareList() ([]*HousewareItem, error) {
+	return c.HousewareListContext(context.Background())
+}
+
+// HousewareListContext is like HousewareList but makes its requests with the
+// given context.
+func (c *Client) HousewareListContext(ctx context.Context) ([]*HousewareItem, error) {
+	return housewareResource.listAll(ctx, c)
+}
+
+// HousewareByName get a houseware item based on its name. The name is compared
+// with its names in each of the client's languages, and then in any other
+// language. An error is returned if the request failed or a non 200 error code
+// was returned or no match was found. Use HousewareByNameContext to control
+// cancellation and deadlines.
+func (c *Client) HousewareByName(name string) (*HousewareItem, error) {
+	return c.HousewareByNameContext(context.Background(), name)
+}
+
+// HousewareByNameContext is like HousewareByName but makes its requests with
+// the given context.
+func (c *Client) HousewareByNameContext(ctx context.Context, name string) (*HousewareItem, error) {
+	return housewareResource.byName(ctx, c, name)
+}
+
+// VariantByID gets a single houseware variant based on its internal ID. An
+// error is returned if the request failed or a non 200 error code was returned
+// or no match was found. Use VariantByIDContext to control cancellation and
+// deadlines.
+func (c *Client) VariantByID(id int) (*ItemVariant, error) {
+	return c.VariantByIDContext(context.Background(), id)
+}
+
+// VariantByIDContext is like VariantByID but makes its requests with the given
+// context.
+func (c *Client) VariantByIDContext(ctx context.Context, id int) (*ItemVariant, error) {
+	housewareList, err := c.HousewareListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range housewareList {
+		for _, variant := range item.Variants {
+			if variant.InternalID == id {
+				return variant, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
@@ -0,0 +1,96 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// IDTranslator maps the identifiers used by community datasets, such as
+// internal game IDs or Nookipedia IDs, to the IDs used by the API. Kinds are
+// named after the API endpoint the IDs belong to, e.g. "songs" or "fish".
+type IDTranslator interface {
+	TranslateID(kind string, externalID string) (int, bool)
+}
+
+// IDMap is an IDTranslator backed by a map of kind to external ID to API ID.
+type IDMap map[string]map[string]int
+
+// LoadIDMap reads an IDMap from JSON of the form {"kind": {"externalID": id}}.
+func LoadIDMap(r io.Reader) (IDMap, error) {
+	m := make(IDMap)
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to decode id map: %w", err)
+	}
+	return m, nil
+}
+
+// TranslateID returns the API ID for the given external ID of a kind, and
+// whether a mapping was found.
+func (m IDMap) TranslateID(kind string, externalID string) (int, bool) {
+	id, ok := m[kind][externalID]
+	return id, ok
+}
+
+// Add records that the external ID of a kind corresponds to the given API ID.
+func (m IDMap) Add(kind string, externalID string, id int) {
+	if m[kind] == nil {
+		m[kind] = make(map[string]int)
+	}
+	m[kind][externalID] = id
+}
+
+// SongByExternalID gets a single song using an ID from another dataset,
+// translated by the given translator. An error is returned if there is no
+// translation or the request failed. Use SongByExternalIDContext to control
+// cancellation and deadlines.
+func (c *Client) SongByExternalID(translator IDTranslator, externalID string) (*Song, error) {
+	return c.SongByExternalIDContext(context.Background(), translator, externalID)
+}
+
+// SongByExternalIDContext is like SongByExternalID but makes its requests with
+// the given context.
+func (c *Client) SongByExternalIDContext(ctx context.Context, translator IDTranslator, externalID string) (*Song, error) {
+	id, ok := translator.TranslateID("songs", externalID)
+	if !ok {
+		return nil, fmt.Errorf("no translation for song id %s", externalID)
+	}
+	return c.SongByIDContext(ctx, id)
+}
+
+// BGMTrackByExternalID gets a single background music track using an ID from
+// another dataset, translated by the given translator. An error is returned if
+// there is no translation or the request failed. Use
+// BGMTrackByExternalIDContext to control cancellation and deadlines.
+func (c *Client) BGMTrackByExternalID(translator IDTranslator, externalID string) (*BGMTrack, error) {
+	return c.BGMTrackByExternalIDContext(context.Background(), translator, externalID)
+}
+
+// BGMTrackByExternalIDContext is like BGMTrackByExternalID but makes its
+// requests with the given context.
+func (c *Client) BGMTrackByExternalIDContext(ctx context.Context, translator IDTranslator, externalID string) (*BGMTrack, error) {
+	id, ok := translator.TranslateID("backgroundmusic", externalID)
+	if !ok {
+		return nil, fmt.Errorf("no translation for background music id %s", externalID)
+	}
+	return c.BGMTrackByIDContext(ctx, id)
+}
+
+// FishByExternalID gets a single fish using an ID from another dataset,
+// translated by the given translator. An error is returned if there is no
+// translation or the request failed. Use FishByExternalIDContext to control
+// cancellation and deadlines.
+func (c *Client) FishByExternalID(translator IDTranslator, externalID string) (*Fish, error) {
+	return c.FishByExternalIDContext(context.Background(), translator, externalID)
+}
+
+// FishByExternalIDContext is like FishByExternalID but makes its requests with
+// the given context.
+func (c *Client) FishByExternalIDContext(ctx context.Context, translator IDTranslator, externalID string) (*Fish, error) {
+	id, ok := translator.TranslateID("fish", externalID)
+	if !ok {
+		return nil, fmt.Errorf("no translation for fish id %s", externalID)
+	}
+	return c.FishByIDContext(ctx, id)
+}
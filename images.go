@@ -0,0 +1,398 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ImageDownload downloads the image of the resource with the given ID in the
+// given category as a PNG file to a given directory. The file is named after
+// the resource's ID. The given download dir must exist before calling this.
+// Returned is the file path of the downloaded image, provided there was no
+// error. Use ImageDownloadContext to control cancellation and deadlines.
+func (c *Client) ImageDownload(category Category, id int, downloadDirectory string) (string, error) {
+	return c.ImageDownloadContext(context.Background(), category, id, downloadDirectory)
+}
+
+// ImageDownloadContext is like ImageDownload but makes its requests with the
+// given context.
+func (c *Client) ImageDownloadContext(ctx context.Context, category Category, id int, downloadDirectory string) (string, error) {
+	return c.download(ctx, downloadRequest{
+		endpoint: imageEndpoint,
+		pathParams: map[string]string{
+			"category":   string(category),
+			"resourceID": strconv.Itoa(id),
+		},
+		fileName:    strconv.Itoa(id) + imageFileExtension,
+		description: string(category) + " image",
+		entity:      id,
+	}, downloadDirectory)
+}
+
+// ImageDownloadTo writes the PNG image of the resource with the given ID in the
+// given category to w. An error is returned if the request failed or a non 200
+// error code was returned. Use ImageDownloadToContext to control cancellation
+// and deadlines.
+func (c *Client) ImageDownloadTo(category Category, id int, w io.Writer) error {
+	return c.ImageDownloadToContext(context.Background(), category, id, w)
+}
+
+// ImageDownloadToContext is like ImageDownloadTo but makes its requests with
+// the given context.
+func (c *Client) ImageDownloadToContext(ctx context.Context, category Category, id int, w io.Writer) error {
+	return c.downloadTo(ctx, downloadRequest{
+		endpoint: imageEndpoint,
+		pathParams: map[string]string{
+			"category":   string(category),
+			"resourceID": strconv.Itoa(id),
+		},
+		description: string(category) + " image",
+		entity:      id,
+	}, w)
+}
+
+// IconDownload downloads the icon of the resource with the given ID in the
+// given category as a PNG file to a given directory. The file is named after
+// the resource's ID. The given download dir must exist before calling this.
+// Returned is the file path of the downloaded icon, provided there was no
+// error. Use IconDownloadContext to control cancellation and deadlines.
+func (c *Client) IconDownload(category Category, id int, downloadDirectory string) (string, error) {
+	return c.IconDownloadContext(context.Background(), category, id, downloadDirectory)
+}
+
+// IconDownloadContext is like IconDownload but makes its requests with the
+// given context.
+func (c *Client) IconDownloadContext(ctx context.Context, category Category, id int, downloadDirectory string) (string, error) {
+	return c.download(ctx, downloadRequest{
+		endpoint: iconEndpoint,
+		pathParams: map[string]string{
+			"category":   string(category),
+			"resourceID": strconv.Itoa(id),
+		},
+		fileName:    strconv.Itoa(id) + imageFileExtension,
+		description: string(category) + " icon",
+		entity:      id,
+	}, downloadDirectory)
+}
+
+// IconDownloadTo writes the PNG icon of the resource with the given ID in the
+// given category to w. An error is returned if the request failed or a non 200
+// error code was returned. Use IconDownloadToContext to control cancellation
+// and deadlines.
+func (c *Client) IconDownloadTo(category Category, id int, w io.Writer) error {
+	return c.IconDownloadToContext(context.Background(), category, id, w)
+}
+
+// IconDownloadToContext is like IconDownloadTo but makes its requests with the
+// given context.
+func (c *Client) IconDownloadToContext(ctx context.Context, category Category, id int, w io.Writer) error {
+	return c.downloadTo(ctx, downloadRequest{
+		endpoint: iconEndpoint,
+		pathParams: map[string]string{
+			"category":   string(category),
+			"resourceID": strconv.Itoa(id),
+		},
+		description: string(category) + " icon",
+		entity:      id,
+	}, w)
+}
+
+// FishImageDownload downloads the image of the fish with the given ID. See
+// ImageDownload. Use FishImageDownloadContext to control cancellation and
+// deadlines.
+func (c *Client) FishImageDownload(id int, downloadDirectory string) (string, error) {
+	return c.FishImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// FishImageDownloadContext is like FishImageDownload but makes its requests
+// with the given context.
+func (c *Client) FishImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.ImageDownloadContext(ctx, FishCategory, id, downloadDirectory)
+}
+
+// FishIconDownload downloads the icon of the fish with the given ID. See
+// IconDownload. Use FishIconDownloadContext to control cancellation and
+// deadlines.
+func (c *Client) FishIconDownload(id int, downloadDirectory string) (string, error) {
+	return c.FishIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// FishIconDownloadContext is like FishIconDownload but makes its requests with
+// the given context.
+func (c *Client) FishIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.IconDownloadContext(ctx, FishCategory, id, downloadDirectory)
+}
+
+// SeaCreatureImageDownload downloads the image of the sea creature with the
+// given ID. See ImageDownload. Use SeaCreatureImageDownloadContext to control
+// cancellation and deadlines.
+func (c *Client) SeaCreatureImageDownload(id int, downloadDirectory string) (string, error) {
+	return c.SeaCreatureImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// SeaCreatureImageDownloadContext is like SeaCreatureImageDownload but makes
+// its requests with the given context.
+func (c *Client) SeaCreatureImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.ImageDownloadContext(ctx, SeaCreatureCategory, id, downloadDirectory)
+}
+
+// SeaCreatureIconDownload downloads the icon of the sea creature with the given
+// ID. See IconDownload. Use SeaCreatureIconDownloadContext to control
+// cancellation and deadlines.
+func (c *Client) SeaCreatureIconDownload(id int, downloadDirectory string) (string, error) {
+	return c.SeaCreatureIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// SeaCreatureIconDownloadContext is like SeaCreatureIconDownload but makes its
+// requests with the given context.
+func (c *Client) SeaCreatureIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.IconDownloadContext(ctx, SeaCreatureCategory, id, downloadDirectory)
+}
+
+// BugImageDownload downloads the image of the bug with the given ID. See
+// ImageDownload. Use BugImageDownloadContext to control cancellation and
+// deadlines.
+func (c *Client) BugImageDownload(id int, downloadDirectory string) (string, error) {
+	return c.BugImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// BugImageDownloadContext is like BugImageDownload but makes its requests with
+// the given context.
+func (c *Client) BugImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.ImageDownloadContext(ctx, BugCategory, id, downloadDirectory)
+}
+
+// BugIconDownload downloads the icon of the bug with the given ID. See
+// IconDownload. Use BugIconDownloadContext to control cancellation and
+// deadlines.
+func (c *Client) BugIconDownload(id int, downloadDirectory string) (string, error) {
+	return c.BugIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// BugIconDownloadContext is like BugIconDownload but makes its requests with
+// the given context.
+func (c *Client) BugIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.IconDownloadContext(ctx, BugCategory, id, downloadDirectory)
+}
+
+// FossilImageDownload downloads the image of the given fossil as a PNG file to
+// a given directory. The file name of the download is that specified as the
+// file name by the API. The given download dir must exist before calling this.
+// Returned is the file path of the downloaded image, provided there was no
+// error. Use FossilImageDownloadContext to control cancellation and deadlines.
+func (c *Client) FossilImageDownload(fossil *Fossil, downloadDirectory string) (string, error) {
+	return c.FossilImageDownloadContext(context.Background(), fossil, downloadDirectory)
+}
+
+// FossilImageDownloadContext is like FossilImageDownload but makes its requests
+// with the given context.
+func (c *Client) FossilImageDownloadContext(ctx context.Context, fossil *Fossil, downloadDirectory string) (string, error) {
+	return c.download(ctx, downloadRequest{
+		endpoint: fossilImageEndpoint,
+		pathParams: map[string]string{
+			"fossilFileName": fossil.FileName,
+		},
+		fileName:    fossil.FileName + imageFileExtension,
+		description: "fossil image",
+		entity:      fossil,
+	}, downloadDirectory)
+}
+
+// FossilImageDownloadTo writes the PNG image of the given fossil to w. An error
+// is returned if the request failed or a non 200 error code was returned. Use
+// FossilImageDownloadToContext to control cancellation and deadlines.
+func (c *Client) FossilImageDownloadTo(fossil *Fossil, w io.Writer) error {
+	return c.FossilImageDownloadToContext(context.Background(), fossil, w)
+}
+
+// FossilImageDownloadToContext is like FossilImageDownloadTo but makes its
+// requests with the given context.
+func (c *Client) FossilImageDownloadToContext(ctx context.Context, fossil *Fossil, w io.Writer) error {
+	return c.downloadTo(ctx, downloadRequest{
+		endpoint: fossilImageEndpoint,
+		pathParams: map[string]string{
+			"fossilFileName": fossil.FileName,
+		},
+		description: "fossil image",
+		entity:      fossil,
+	}, w)
+}
+
+// ArtImageDownload downloads the image of the genuine version of the given
+// artwork as a PNG file to a given directory. The file name of the download is
+// that specified as the file name by the API. The given download dir must exist
+// before calling this. Returned is the file path of the downloaded image,
+// provided there was no error. Use ArtImageDownloadContext to control
+// cancellation and deadlines.
+func (c *Client) ArtImageDownload(art *Art, downloadDirectory string) (string, error) {
+	return c.ArtImageDownloadContext(context.Background(), art, downloadDirectory)
+}
+
+// ArtImageDownloadContext is like ArtImageDownload but makes its requests with
+// the given context.
+func (c *Client) ArtImageDownloadContext(ctx context.Context, art *Art, downloadDirectory string) (string, error) {
+	return c.download(ctx, downloadRequest{
+		endpoint: artImageEndpoint,
+		pathParams: map[string]string{
+			"artFileName": art.FileName,
+		},
+		fileName:    art.FileName + imageFileExtension,
+		description: "art image",
+		entity:      art,
+	}, downloadDirectory)
+}
+
+// ArtImageDownloadTo writes the PNG image of the genuine version of the given
+// artwork to w. An error is returned if the request failed or a non 200 error
+// code was returned. Use ArtImageDownloadToContext to control cancellation and
+// deadlines.
+func (c *Client) ArtImageDownloadTo(art *Art, w io.Writer) error {
+	return c.ArtImageDownloadToContext(context.Background(), art, w)
+}
+
+// ArtImageDownloadToContext is like ArtImageDownloadTo but makes its requests
+// with the given context.
+func (c *Client) ArtImageDownloadToContext(ctx context.Context, art *Art, w io.Writer) error {
+	return c.downloadTo(ctx, downloadRequest{
+		endpoint: artImageEndpoint,
+		pathParams: map[string]string{
+			"artFileName": art.FileName,
+		},
+		description: "art image",
+		entity:      art,
+	}, w)
+}
+
+// VariantImageDownload downloads the image of the given item variant as a PNG
+// file to a given directory. The file name of the download is that specified as
+// the file name by the API. The given download dir must exist before calling
+// this. Returned is the file path of the downloaded image, provided there was
+// no error. Use VariantImageDownloadContext to control cancellation and
+// deadlines.
+func (c *Client) VariantImageDownload(variant *ItemVariant, downloadDirectory string) (string, error) {
+	return c.VariantImageDownloadContext(context.Background(), variant, downloadDirectory)
+}
+
+// VariantImageDownloadContext is like VariantImageDownload but makes its
+// requests with the given context.
+func (c *Client) VariantImageDownloadContext(ctx context.Context, variant *ItemVariant, downloadDirectory string) (string, error) {
+	if variant.ImageURI == "" {
+		return "", fmt.Errorf("variant has no image")
+	}
+	return c.download(ctx, variantImageRequest(variant), downloadDirectory)
+}
+
+// variantImageRequest describes the PNG image of an item variant.
+func variantImageRequest(variant *ItemVariant) downloadRequest {
+	return downloadRequest{
+		endpoint:    endpoint{path: variant.ImageURI, asset: true},
+		fileName:    variant.FileName + imageFileExtension,
+		description: "item variant image",
+		entity:      variant,
+	}
+}
+
+// VariantImageDownloadTo writes the PNG image of the given item variant to w.
+// An error is returned if the request failed or a non 200 error code was
+// returned. Use VariantImageDownloadToContext to control cancellation and
+// deadlines.
+func (c *Client) VariantImageDownloadTo(variant *ItemVariant, w io.Writer) error {
+	return c.VariantImageDownloadToContext(context.Background(), variant, w)
+}
+
+// VariantImageDownloadToContext is like VariantImageDownloadTo but makes its
+// requests with the given context.
+func (c *Client) VariantImageDownloadToContext(ctx context.Context, variant *ItemVariant, w io.Writer) error {
+	if variant.ImageURI == "" {
+		return fmt.Errorf("variant has no image")
+	}
+	return c.downloadTo(ctx, downloadRequest{
+		endpoint:    endpoint{path: variant.ImageURI, asset: true},
+		description: "item variant image",
+		entity:      variant,
+	}, w)
+}
+
+// HousewareImagesDownload downloads the images of every variant of the given
+// houseware item into a subdirectory of the given directory named after the
+// item. See VariantImagesDownload. Use HousewareImagesDownloadContext to
+// control cancellation and deadlines.
+func (c *Client) HousewareImagesDownload(item *HousewareItem, downloadDirectory string) ([]string, error) {
+	return c.HousewareImagesDownloadContext(context.Background(), item, downloadDirectory)
+}
+
+// HousewareImagesDownloadContext is like HousewareImagesDownload but makes its
+// requests with the given context.
+func (c *Client) HousewareImagesDownloadContext(ctx context.Context, item *HousewareItem, downloadDirectory string) ([]string, error) {
+	return c.VariantImagesDownloadContext(ctx, item.Name, item.Variants, downloadDirectory)
+}
+
+// WallMountedImagesDownload downloads the images of every variant of the given
+// wall-mounted item into a subdirectory of the given directory named after the
+// item. See VariantImagesDownload. Use WallMountedImagesDownloadContext to
+// control cancellation and deadlines.
+func (c *Client) WallMountedImagesDownload(item *WallMountedItem, downloadDirectory string) ([]string, error) {
+	return c.WallMountedImagesDownloadContext(context.Background(), item, downloadDirectory)
+}
+
+// WallMountedImagesDownloadContext is like WallMountedImagesDownload but makes
+// its requests with the given context.
+func (c *Client) WallMountedImagesDownloadContext(ctx context.Context, item *WallMountedItem, downloadDirectory string) ([]string, error) {
+	return c.VariantImagesDownloadContext(ctx, item.Name, item.Variants, downloadDirectory)
+}
+
+// MiscItemImagesDownload downloads the images of every variant of the given
+// misc item into a subdirectory of the given directory named after the item.
+// See VariantImagesDownload. Use MiscItemImagesDownloadContext to control
+// cancellation and deadlines.
+func (c *Client) MiscItemImagesDownload(item *MiscItem, downloadDirectory string) ([]string, error) {
+	return c.MiscItemImagesDownloadContext(context.Background(), item, downloadDirectory)
+}
+
+// MiscItemImagesDownloadContext is like MiscItemImagesDownload but makes its
+// requests with the given context.
+func (c *Client) MiscItemImagesDownloadContext(ctx context.Context, item *MiscItem, downloadDirectory string) ([]string, error) {
+	return c.VariantImagesDownloadContext(ctx, item.Name, item.Variants, downloadDirectory)
+}
+
+// VariantImagesDownload downloads the images of all the given variants of an
+// item into a subdirectory of the given directory, named after the item. The
+// subdirectory is created if needed, but the given download dir must exist
+// before calling this. Returned are the file paths of the downloaded images,
+// provided there was no error. In dry-run mode the paths a real run would
+// use, following the client's FileNamer and overwrite policy, are returned
+// without anything being created or downloaded. Use
+// VariantImagesDownloadContext to control cancellation and deadlines.
+func (c *Client) VariantImagesDownload(itemName string, variants []*ItemVariant, downloadDirectory string) ([]string, error) {
+	return c.VariantImagesDownloadContext(context.Background(), itemName, variants, downloadDirectory)
+}
+
+// VariantImagesDownloadContext is like VariantImagesDownload but makes its
+// requests with the given context.
+func (c *Client) VariantImagesDownloadContext(ctx context.Context, itemName string, variants []*ItemVariant, downloadDirectory string) ([]string, error) {
+	if !c.dirExists(downloadDirectory) {
+		return nil, fmt.Errorf("destination download directory does not exist")
+	}
+	itemDirectory := downloadFilePath(downloadDirectory, itemName)
+	if !c.dryRun {
+		if err := c.downloadFS.MkdirAll(itemDirectory, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create item download directory: %w", err)
+		}
+	}
+	paths := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		if variant.ImageURI == "" {
+			return paths, fmt.Errorf("variant has no image")
+		}
+		req := variantImageRequest(variant)
+		req.newDirectory = true
+		outputFilePath, err := c.download(ctx, req, itemDirectory)
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, outputFilePath)
+	}
+	return paths, nil
+}
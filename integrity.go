@@ -0,0 +1,149 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// BrokenReference is a reference from one entry to another entry or asset that
+// does not exist.
+type BrokenReference struct {
+	// Category and Entry name the entry holding the reference.
+	Category Category
+	Entry    string
+	// Target describes what is referred to, such as a material name.
+	Target string
+	// Reason describes why the reference is broken.
+	Reason string
+}
+
+func (r BrokenReference) String() string {
+	return fmt.Sprintf("%s %q: %s: %s", r.Category, r.Entry, r.Target, r.Reason)
+}
+
+// IntegrityReport lists the broken references found by CheckIntegrity.
+type IntegrityReport struct {
+	Broken []BrokenReference
+	// Skipped describes the checks that could not be made, such as those for
+	// data the client has no source for.
+	Skipped []string
+}
+
+// OK reports whether no broken references were found.
+func (r *IntegrityReport) OK() bool {
+	return len(r.Broken) == 0
+}
+
+// CheckIntegrity validates the references between categories: that the
+// materials of each recipe are misc items, and that the audio file of each
+// background music track can be reached, which takes a HEAD request per
+// track. This is useful after merging sources or applying overrides. Checks
+// that need data the client has no source for are skipped and listed in the
+// report. An error is returned if a list could not be fetched. Use
+// CheckIntegrityContext to control cancellation and deadlines.
+func (c *Client) CheckIntegrity() (*IntegrityReport, error) {
+	return c.CheckIntegrityContext(context.Background())
+}
+
+// CheckIntegrityContext is like CheckIntegrity but makes its requests with
+// the given context.
+func (c *Client) CheckIntegrityContext(ctx context.Context) (*IntegrityReport, error) {
+	report := &IntegrityReport{}
+	if err := c.checkRecipeMaterials(ctx, report); err != nil {
+		return nil, err
+	}
+	if err := c.checkBGMAssets(ctx, report); err != nil {
+		return nil, err
+	}
+	report.Skipped = append(report.Skipped, "villager photos: the API provides no villager or photo data")
+	return report, nil
+}
+
+func (c *Client) checkRecipeMaterials(ctx context.Context, report *IntegrityReport) error {
+	if c.recipeProvider == nil {
+		report.Skipped = append(report.Skipped, "recipe materials: no recipe provider is configured")
+		return nil
+	}
+	recipes, err := c.RecipeListContext(ctx)
+	if err != nil {
+		return err
+	}
+	miscItems, err := c.MiscItemListContext(ctx)
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for _, item := range miscItems {
+		names[strings.ToLower(item.Name)] = true
+		for _, variant := range item.Variants {
+			for _, name := range variant.Name {
+				names[strings.ToLower(name)] = true
+			}
+		}
+	}
+	for _, recipe := range recipes {
+		for _, material := range recipe.Materials {
+			if !names[strings.ToLower(material.Name)] {
+				report.Broken = append(report.Broken, BrokenReference{
+					Category: RecipeCategory,
+					Entry:    recipe.Name,
+					Target:   "material " + material.Name,
+					Reason:   "no misc item has this name",
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) checkBGMAssets(ctx context.Context, report *IntegrityReport) error {
+	bgmList, err := c.BGMListContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, track := range bgmList {
+		if track.FileName == "" {
+			report.Broken = append(report.Broken, BrokenReference{
+				Category: BGMCategory,
+				Entry:    strconv.Itoa(track.ID),
+				Target:   "file name",
+				Reason:   "the track has no file name",
+			})
+			continue
+		}
+		if reason := c.checkAsset(ctx, bgmFileEndpoint, map[string]string{"trackID": strconv.Itoa(track.ID)}); reason != "" {
+			report.Broken = append(report.Broken, BrokenReference{
+				Category: BGMCategory,
+				Entry:    track.FileName,
+				Target:   "audio file",
+				Reason:   reason,
+			})
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAsset makes a HEAD request for an asset, returning why it cannot be
+// reached or an empty string if it can.
+func (c *Client) checkAsset(ctx context.Context, assetEndpoint endpoint, pathParams map[string]string) string {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	resp, err := c.restClient.R().
+		SetContext(ctx).
+		SetPathParam("apiVersion", strconv.Itoa(apiVersion)).
+		SetPathParams(pathParams).
+		Head(c.assetPath(assetEndpoint))
+	if err != nil {
+		return err.Error()
+	}
+	if resp.StatusCode() >= http.StatusBadRequest {
+		return fmt.Sprintf("received status code %d", resp.StatusCode())
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+//go:build go1.23
+
+package goacnh
+
+import (
+	"context"
+	"iter"
+)
+
+// acnhClientIterators is the part of ACNHClient that needs the iter package,
+// which is only available from Go 1.23.
+type acnhClientIterators interface {
+	Songs(ctx context.Context) iter.Seq2[*Song, error]
+	BGMTracks(ctx context.Context) iter.Seq2[*BGMTrack, error]
+	Fish(ctx context.Context) iter.Seq2[*Fish, error]
+	SeaCreatures(ctx context.Context) iter.Seq2[*SeaCreature, error]
+	Fossils(ctx context.Context) iter.Seq2[*Fossil, error]
+	Art(ctx context.Context) iter.Seq2[*Art, error]
+	Houseware(ctx context.Context) iter.Seq2[*HousewareItem, error]
+	WallMounted(ctx context.Context) iter.Seq2[*WallMountedItem, error]
+	MiscItems(ctx context.Context) iter.Seq2[*MiscItem, error]
+}
+
+// Songs returns an iterator over all the songs that the API provides, which
+// decodes them one at a time so that callers can stop early without the whole
+// list being built. If the list could not be fetched or decoded, the error is
+// yielded with a nil song and iteration ends.
+func (c *Client) Songs(ctx context.Context) iter.Seq2[*Song, error] {
+	return entities(ctx, c, songResource)
+}
+
+// BGMTracks returns an iterator over all the background music tracks that the
+// API provides, which decodes them one at a time so that callers can stop early
+// without the whole list being built. If the list could not be fetched or
+// decoded, the error is yielded with a nil track and iteration ends.
+func (c *Client) BGMTracks(ctx context.Context) iter.Seq2[*BGMTrack, error] {
+	return entities(ctx, c, bgmResource)
+}
+
+// Fish returns an iterator over all the fish that the API provides, which
+// decodes them one at a time so that callers can stop early without the whole
+// list being built. If the list could not be fetched or decoded, the error is
+// yielded with a nil fish and iteration ends.
+func (c *Client) Fish(ctx context.Context) iter.Seq2[*Fish, error] {
+	return entities(ctx, c, fishResource)
+}
+
+// SeaCreatures returns an iterator over all the sea creatures that the API
+// provides, which decodes them one at a time so that callers can stop early
+// without the whole list being built. If the list could not be fetched or
+// decoded, the error is yielded with a nil sea creature and iteration ends.
+func (c *Client) SeaCreatures(ctx context.Context) iter.Seq2[*SeaCreature, error] {
+	return entities(ctx, c, seaCreatureResource)
+}
+
+// Fossils returns an iterator over all the fossils that the API provides, which
+// decodes them one at a time so that callers can stop early without the whole
+// list being built. If the list could not be fetched or decoded, the error is
+// yielded with a nil fossil and iteration ends.
+func (c *Client) Fossils(ctx context.Context) iter.Seq2[*Fossil, error] {
+	return entities(ctx, c, fossilResource)
+}
+
+// Art returns an iterator over all the artwork that the API provides, which
+// decodes them one at a time so that callers can stop early without the whole
+// list being built. If the list could not be fetched or decoded, the error is
+// yielded with a nil piece of art and iteration ends.
+func (c *Client) Art(ctx context.Context) iter.Seq2[*Art, error] {
+	return entities(ctx, c, artResource)
+}
+
+// Houseware returns an iterator over all the houseware items that the API
+// provides, which decodes them one at a time so that callers can stop early
+// without the whole list being built. If the list could not be fetched or
+// decoded, the error is yielded with a nil item and iteration ends.
+func (c *Client) Houseware(ctx context.Context) iter.Seq2[*HousewareItem, error] {
+	return entities(ctx, c, housewareResource)
+}
+
+// WallMounted returns an iterator over all the wall-mounted items that the API
+// provides, which decodes them one at a time so that callers can stop early
+// without the whole list being built. If the list could not be fetched or
+// decoded, the error is yielded with a nil item and iteration ends.
+func (c *Client) WallMounted(ctx context.Context) iter.Seq2[*WallMountedItem, error] {
+	return entities(ctx, c, wallMountedResource)
+}
+
+// MiscItems returns an iterator over all the misc items that the API provides,
+// which decodes them one at a time so that callers can stop early without the
+// whole list being built. If the list could not be fetched or decoded, the
+// error is yielded with a nil item and iteration ends.
+func (c *Client) MiscItems(ctx context.Context) iter.Seq2[*MiscItem, error] {
+	return entities(ctx, c, miscItemResource)
+}
+
+func entities[T any](ctx context.Context, c *Client, r *resource[T]) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		err := r.each(ctx, c, func(entity *T) bool {
+			return yield(entity, nil)
+		})
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !go1.23
+
+package goacnh
+
+// acnhClientIterators is the part of ACNHClient that needs the iter package,
+// which this version of Go does not have.
+type acnhClientIterators interface{}
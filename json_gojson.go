@@ -0,0 +1,18 @@
+//go:build gojson
+
+package goacnh
+
+import (
+	"io"
+
+	json "github.com/goccy/go-json"
+)
+
+// jsonUnmarshal is the JSON decoder used for all API responses. This build uses
+// github.com/goccy/go-json, which is noticeably faster on the larger catalogue
+// endpoints.
+var jsonUnmarshal = json.Unmarshal
+
+func newJSONDecoder(r io.Reader) jsonDecoder {
+	return json.NewDecoder(r)
+}
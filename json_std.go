@@ -0,0 +1,16 @@
+//go:build !gojson
+
+package goacnh
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// jsonUnmarshal is the JSON decoder used for all API responses. Build with the
+// gojson tag to swap encoding/json for github.com/goccy/go-json.
+var jsonUnmarshal = json.Unmarshal
+
+func newJSONDecoder(r io.Reader) jsonDecoder {
+	return json.NewDecoder(r)
+}
@@ -0,0 +1,88 @@
+package goacnh
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// listFlightGroup shares a single fetch of a list between concurrent callers.
+// The fetch is made with a context detached from any one caller's, so that a
+// caller that is cancelled or times out only gives up its own wait, and is
+// cancelled once every caller has given up.
+type listFlightGroup struct {
+	mu      sync.Mutex
+	flights map[Category]*listFlight
+}
+
+// listFlight is a fetch of a list in progress.
+type listFlight struct {
+	cancel  context.CancelFunc
+	waiters int
+	done    chan struct{}
+	data    []byte
+	err     error
+}
+
+// do returns the result of fetch for the given category, joining a fetch that
+// is already being made for it if there is one. The values of ctx are passed
+// on to fetch, but not its cancellation, and the error of ctx is returned if
+// it is done before the fetch is.
+func (g *listFlightGroup) do(ctx context.Context, category Category, fetch func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = make(map[Category]*listFlight)
+	}
+	flight, ok := g.flights[category]
+	if !ok {
+		flightCtx, cancel := context.WithCancel(detachedContext{ctx})
+		flight = &listFlight{cancel: cancel, done: make(chan struct{})}
+		g.flights[category] = flight
+		go func() {
+			flight.data, flight.err = fetch(flightCtx)
+			cancel()
+			g.mu.Lock()
+			g.forget(category, flight)
+			g.mu.Unlock()
+			close(flight.done)
+		}()
+	}
+	flight.waiters++
+	g.mu.Unlock()
+	select {
+	case <-flight.done:
+		return flight.data, flight.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		flight.waiters--
+		if flight.waiters == 0 {
+			flight.cancel()
+			g.forget(category, flight)
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// forget removes flight from the group, so that later callers start a new
+// fetch, unless it has already been replaced. It must be called with the
+// group's lock held.
+func (g *listFlightGroup) forget(category Category, flight *listFlight) {
+	if g.flights[category] == flight {
+		delete(g.flights, category)
+	}
+}
+
+// detachedContext is a context with the values of its parent, but which is
+// never done.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
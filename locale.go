@@ -0,0 +1,74 @@
+package goacnh
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultLanguageCode string = "EUen"
+)
+
+// WithLanguages sets the language codes, such as USen, EUen or JPja, used when
+// reading and matching localized names. They are tried in the given order, so
+// later languages act as fallbacks for entities that are missing a name in
+// earlier ones. The default is EUen alone.
+func WithLanguages(codes ...string) Option {
+	return func(c *Client) {
+		c.languages = codes
+	}
+}
+
+// WithLocale sets the language code, such as USen or JPja, used for reading
+// and matching localized names. It is equivalent to WithLanguages with a single
+// language.
+func WithLocale(code string) Option {
+	return WithLanguages(code)
+}
+
+// LocalName returns the name from the given set of localized names in the first
+// of the client's languages that has one. If none of them do, the name in any
+// other language, in the order of their keys, is returned so that callers are
+// never left with an empty string when some name exists.
+func (c *Client) LocalName(names map[string]string) string {
+	for _, code := range c.languages {
+		if name := names[fmt.Sprintf("name-%s", code)]; name != "" {
+			return name
+		}
+	}
+	if name := names[fmt.Sprintf("name-%s", defaultLanguageCode)]; name != "" {
+		return name
+	}
+	keys := make([]string, 0, len(names))
+	for key := range names {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if names[key] != "" {
+			return names[key]
+		}
+	}
+	return ""
+}
+
+// hasName reports whether any of the given localized names matches name,
+// ignoring case. Only the names in the client's languages are compared, unless
+// anyLanguage is set.
+func (c *Client) hasName(names map[string]string, name string, anyLanguage bool) bool {
+	if anyLanguage {
+		for _, localName := range names {
+			if strings.EqualFold(localName, name) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, code := range c.languages {
+		if localName := names[fmt.Sprintf("name-%s", code)]; localName != "" && strings.EqualFold(localName, name) {
+			return true
+		}
+	}
+	return false
+}
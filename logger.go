@@ -0,0 +1,16 @@
+package goacnh
+
+// Logger receives messages describing each request and response, retry, and
+// download the client makes. It is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// WithLogger makes the client describe its requests, the responses to them,
+// retries, and completed downloads to the given logger. By default nothing is
+// logged, and builds with the notelemetry tag never log anything.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
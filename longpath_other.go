@@ -0,0 +1,15 @@
+//go:build !windows
+
+package goacnh
+
+const (
+	// maxPathLength is PATH_MAX on Linux, which most other systems match or
+	// exceed.
+	maxPathLength int = 4096
+)
+
+// longPath returns p unchanged, as only Windows needs special handling of long
+// paths.
+func longPath(p string) string {
+	return p
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+package goacnh
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// maxPathLength is the longest path Windows accepts once in the \\?\
+	// extended-length form.
+	maxPathLength int = 32767
+	// maxLegacyPathLength is MAX_PATH, less room for the terminating NUL.
+	maxLegacyPathLength int    = 259
+	extendedPathPrefix  string = `\\?\`
+)
+
+// longPath returns p in the \\?\ extended-length form if it is too long for
+// the legacy Windows path limit, so that deep mirror directories can still be
+// written to. UNC paths are converted to the \\?\UNC\ form.
+func longPath(p string) string {
+	if len(p) <= maxLegacyPathLength || strings.HasPrefix(p, extendedPathPrefix) {
+		return p
+	}
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return p
+	}
+	if strings.HasPrefix(abs, `\\`) {
+		return extendedPathPrefix + `UNC\` + abs[2:]
+	}
+	return extendedPathPrefix + abs
+}
@@ -0,0 +1,29 @@
+package goacnh
+
+import "time"
+
+// MetricsHook is called by the client as it works, so that services embedding
+// it can monitor the health of the API. Endpoints are given as the API's path
+// templates, such as /v{apiVersion}/fish/{fishID}, so they can be used as
+// metric labels. The goacnhprom package adapts a MetricsHook to Prometheus.
+type MetricsHook interface {
+	// ObserveRequest is called after each attempt of each request that is
+	// sent to the API, with its status code, or the error that stopped it
+	// from getting a response.
+	ObserveRequest(endpoint string, statusCode int, duration time.Duration, err error)
+	// ObserveDownload is called after each successful download with the
+	// number of bytes downloaded.
+	ObserveDownload(endpoint string, bytes int64)
+	// ObserveCache is called on each lookup in a cache, named "memory" for
+	// WithCache and "disk" for WithDiskCache, reporting whether it was a hit.
+	ObserveCache(cache string, hit bool)
+}
+
+// WithMetrics makes the client report its requests, downloads and cache
+// lookups to the given hook. It has no effect in builds with the notelemetry
+// tag.
+func WithMetrics(hook MetricsHook) Option {
+	return func(c *Client) {
+		c.metrics = hook
+	}
+}
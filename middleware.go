@@ -0,0 +1,30 @@
+package goacnh
+
+import "net/http"
+
+// RoundTripFunc allows an ordinary function to be used as an
+// http.RoundTripper.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the sending of every HTTP request the client makes, such as
+// to add authentication headers, cache responses, or inject failures in tests.
+// It is given the next step in sending a request and returns the step that
+// replaces it.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use adds middleware around every request the client sends, including each
+// attempt of a retried request. The first middleware given is the outermost,
+// and middleware added by later calls wraps that added by earlier ones. Use
+// should be called before the client is used to make any requests.
+func (c *Client) Use(middleware ...Middleware) {
+	next := RoundTripFunc(c.baseTransport().RoundTrip)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		next = middleware[i](next)
+	}
+	c.restClient.SetTransport(next)
+}
@@ -0,0 +1,52 @@
+package goacnh
+
+import "context"
+
+// MiscItem represents a miscellaneous item, such as a flower, fruit, material
+// or tool, as represented via the API. Like houseware, each item is provided as
+// a list of its variants, which all share the same name.
+type MiscItem struct {
+	Name     string
+	Variants []*ItemVariant
+}
+
+func newMiscItem(name string, variants []*ItemVariant) *MiscItem {
+	return &MiscItem{Name: name, Variants: variants}
+}
+
+var miscItemResource = &resource[MiscItem]{
+	category:        MiscCategory,
+	description:     "misc item",
+	listDescription: "misc item list",
+	list:            miscListEndpoint,
+	stream:          variantStream(newMiscItem),
+	names:           func(item *MiscItem) []map[string]string { return variantNames(item.Variants) },
+}
+
+// MiscItemList returns all the misc items that the API provides. An error is
+// returned if the request failed or a non 200 error code was returned. Use
+// MiscItemListContext to control cancellation and deadlines.
+func (c *Client) MiscItemList() ([]*MiscItem, error) {
+	return c.MiscItemListContext(context.Background())
+}
+
+// MiscItemListContext is like MiscItemList but makes its requests with the
+// given context.
+func (c *Client) MiscItemListContext(ctx context.Context) ([]*MiscItem, error) {
+	return miscItemResource.listAll(ctx, c)
+}
+
+// MiscItemByName get a misc item based on its name. The name is compared with
+// its names in each of the client's languages, and then in any other language.
+// An error is returned if the request failed or a non 200 error code was
+// returned or no match was found. Use MiscItemByNameContext to control
+// cancellation and deadlines.
+func (c *Client) MiscItemByName(name string) (*MiscItem, error) {
+	return c.MiscItemByNameContext(context.Background(), name)
+}
+
+// MiscItemByNameContext is like MiscItemByName but makes its requests with the
+// given context.
+func (c *Client) MiscItemByNameContext(ctx context.Context, name string) (*MiscItem, error) {
+	return miscItemResource.byName(ctx, c, name)
+}
@@ -0,0 +1,89 @@
+//go:build go1.23
+
+package mock
+
+import (
+	"context"
+	"iter"
+
+	goacnh "github.com/willfantom/go-acnh"
+)
+
+// Songs yields what SongListContext returns.
+func (m *Client) Songs(ctx context.Context) iter.Seq2[*goacnh.Song, error] {
+	return seq(func() ([]*goacnh.Song, error) {
+		return m.SongListContext(ctx)
+	})
+}
+
+// BGMTracks yields what BGMListContext returns.
+func (m *Client) BGMTracks(ctx context.Context) iter.Seq2[*goacnh.BGMTrack, error] {
+	return seq(func() ([]*goacnh.BGMTrack, error) {
+		return m.BGMListContext(ctx)
+	})
+}
+
+// Fish yields what FishListContext returns.
+func (m *Client) Fish(ctx context.Context) iter.Seq2[*goacnh.Fish, error] {
+	return seq(func() ([]*goacnh.Fish, error) {
+		return m.FishListContext(ctx)
+	})
+}
+
+// SeaCreatures yields what SeaCreatureListContext returns.
+func (m *Client) SeaCreatures(ctx context.Context) iter.Seq2[*goacnh.SeaCreature, error] {
+	return seq(func() ([]*goacnh.SeaCreature, error) {
+		return m.SeaCreatureListContext(ctx)
+	})
+}
+
+// Fossils yields what FossilListContext returns.
+func (m *Client) Fossils(ctx context.Context) iter.Seq2[*goacnh.Fossil, error] {
+	return seq(func() ([]*goacnh.Fossil, error) {
+		return m.FossilListContext(ctx)
+	})
+}
+
+// Art yields what ArtListContext returns.
+func (m *Client) Art(ctx context.Context) iter.Seq2[*goacnh.Art, error] {
+	return seq(func() ([]*goacnh.Art, error) {
+		return m.ArtListContext(ctx)
+	})
+}
+
+// Houseware yields what HousewareListContext returns.
+func (m *Client) Houseware(ctx context.Context) iter.Seq2[*goacnh.HousewareItem, error] {
+	return seq(func() ([]*goacnh.HousewareItem, error) {
+		return m.HousewareListContext(ctx)
+	})
+}
+
+// WallMounted yields what WallMountedListContext returns.
+func (m *Client) WallMounted(ctx context.Context) iter.Seq2[*goacnh.WallMountedItem, error] {
+	return seq(func() ([]*goacnh.WallMountedItem, error) {
+		return m.WallMountedListContext(ctx)
+	})
+}
+
+// MiscItems yields what MiscItemListContext returns.
+func (m *Client) MiscItems(ctx context.Context) iter.Seq2[*goacnh.MiscItem, error] {
+	return seq(func() ([]*goacnh.MiscItem, error) {
+		return m.MiscItemListContext(ctx)
+	})
+}
+
+// seq yields each element that list returns, or its error.
+func seq[T any](list func() ([]*T, error)) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		elements, err := list()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, element := range elements {
+			if !yield(element, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,1583 @@
+// Package mock provides a test double for goacnh.ACNHClient, so that code
+// using the client can be tested without making HTTP requests.
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	goacnh "github.com/willfantom/go-acnh"
+)
+
+// ErrNotMocked is returned by the methods of Client that have not been given
+// a function.
+var ErrNotMocked = errors.New("method not mocked")
+
+// Client is a goacnh.ACNHClient whose methods call the function in the field
+// of the same name with a Func suffix. A method without a function falls back
+// to its Context form, if it has one, called with context.Background(), and
+// otherwise returns zero values and, where it returns an error,
+// ErrNotMocked.
+type Client struct {
+	ArtByIDFunc                          func(int) (*goacnh.Art, error)
+	ArtByIDContextFunc                   func(context.Context, int) (*goacnh.Art, error)
+	ArtByIDsFunc                         func([]int) ([]*goacnh.Art, error)
+	ArtByIDsContextFunc                  func(context.Context, []int) ([]*goacnh.Art, error)
+	ArtByNameFunc                        func(string) (*goacnh.Art, error)
+	ArtByNameContextFunc                 func(context.Context, string) (*goacnh.Art, error)
+	ArtImageDownloadFunc                 func(*goacnh.Art, string) (string, error)
+	ArtImageDownloadContextFunc          func(context.Context, *goacnh.Art, string) (string, error)
+	ArtImageDownloadToFunc               func(*goacnh.Art, io.Writer) error
+	ArtImageDownloadToContextFunc        func(context.Context, *goacnh.Art, io.Writer) error
+	ArtListFunc                          func() ([]*goacnh.Art, error)
+	ArtListContextFunc                   func(context.Context) ([]*goacnh.Art, error)
+	AssetURLFunc                         func(string) string
+	BGMDownloadFunc                      func(*goacnh.BGMTrack, string) (string, error)
+	BGMDownloadContextFunc               func(context.Context, *goacnh.BGMTrack, string) (string, error)
+	BGMDownloadTempFunc                  func(*goacnh.BGMTrack) (string, error)
+	BGMDownloadTempContextFunc           func(context.Context, *goacnh.BGMTrack) (string, error)
+	BGMDownloadToFunc                    func(*goacnh.BGMTrack, io.Writer) error
+	BGMDownloadToContextFunc             func(context.Context, *goacnh.BGMTrack, io.Writer) error
+	BGMListFunc                          func() ([]*goacnh.BGMTrack, error)
+	BGMListByHourFunc                    func(int) ([]*goacnh.BGMTrack, error)
+	BGMListByHourContextFunc             func(context.Context, int) ([]*goacnh.BGMTrack, error)
+	BGMListByWeatherFunc                 func(goacnh.Weather) ([]*goacnh.BGMTrack, error)
+	BGMListByWeatherContextFunc          func(context.Context, goacnh.Weather) ([]*goacnh.BGMTrack, error)
+	BGMListContextFunc                   func(context.Context) ([]*goacnh.BGMTrack, error)
+	BGMReportFunc                        func() (*goacnh.BGMReport, error)
+	BGMReportContextFunc                 func(context.Context) (*goacnh.BGMReport, error)
+	BGMTrackByExternalIDFunc             func(goacnh.IDTranslator, string) (*goacnh.BGMTrack, error)
+	BGMTrackByExternalIDContextFunc      func(context.Context, goacnh.IDTranslator, string) (*goacnh.BGMTrack, error)
+	BGMTrackByIDFunc                     func(int) (*goacnh.BGMTrack, error)
+	BGMTrackByIDContextFunc              func(context.Context, int) (*goacnh.BGMTrack, error)
+	BGMTrackByQueryFunc                  func(int, goacnh.Weather) (*goacnh.BGMTrack, error)
+	BGMTrackByQueryContextFunc           func(context.Context, int, goacnh.Weather) (*goacnh.BGMTrack, error)
+	BGMTracksByIDsFunc                   func([]int) ([]*goacnh.BGMTrack, error)
+	BGMTracksByIDsContextFunc            func(context.Context, []int) ([]*goacnh.BGMTrack, error)
+	BGMURLFunc                           func(*goacnh.BGMTrack) string
+	BugIconDownloadFunc                  func(int, string) (string, error)
+	BugIconDownloadContextFunc           func(context.Context, int, string) (string, error)
+	BugImageDownloadFunc                 func(int, string) (string, error)
+	BugImageDownloadContextFunc          func(context.Context, int, string) (string, error)
+	CapabilitiesFunc                     func() *goacnh.Capabilities
+	CategoryJSONFunc                     func(context.Context, goacnh.Category) ([]byte, error)
+	CheckIntegrityFunc                   func() (*goacnh.IntegrityReport, error)
+	CheckIntegrityContextFunc            func(context.Context) (*goacnh.IntegrityReport, error)
+	CoverageReportFunc                   func(context.Context) (*goacnh.CoverageReport, error)
+	CustomEntriesFunc                    func(...goacnh.Category) []*goacnh.CustomEntry
+	CustomEntryByIDFunc                  func(int) (*goacnh.CustomEntry, error)
+	CustomEntryByNameFunc                func(string) (*goacnh.CustomEntry, error)
+	DownloadAllBGMFunc                   func(string) ([]*goacnh.BGMDownloadResult, error)
+	DownloadAllBGMContextFunc            func(context.Context, string) ([]*goacnh.BGMDownloadResult, error)
+	DownloadAllSongsFunc                 func(string) ([]*goacnh.SongDownloadResult, error)
+	DownloadAllSongsContextFunc          func(context.Context, string) ([]*goacnh.SongDownloadResult, error)
+	FishByExternalIDFunc                 func(goacnh.IDTranslator, string) (*goacnh.Fish, error)
+	FishByExternalIDContextFunc          func(context.Context, goacnh.IDTranslator, string) (*goacnh.Fish, error)
+	FishByIDFunc                         func(int) (*goacnh.Fish, error)
+	FishByIDContextFunc                  func(context.Context, int) (*goacnh.Fish, error)
+	FishByIDsFunc                        func([]int) ([]*goacnh.Fish, error)
+	FishByIDsContextFunc                 func(context.Context, []int) ([]*goacnh.Fish, error)
+	FishByNameFunc                       func(string) (*goacnh.Fish, error)
+	FishByNameContextFunc                func(context.Context, string) (*goacnh.Fish, error)
+	FishIconDownloadFunc                 func(int, string) (string, error)
+	FishIconDownloadContextFunc          func(context.Context, int, string) (string, error)
+	FishImageDownloadFunc                func(int, string) (string, error)
+	FishImageDownloadContextFunc         func(context.Context, int, string) (string, error)
+	FishListFunc                         func() ([]*goacnh.Fish, error)
+	FishListContextFunc                  func(context.Context) ([]*goacnh.Fish, error)
+	FossilByNameFunc                     func(string) (*goacnh.Fossil, error)
+	FossilByNameContextFunc              func(context.Context, string) (*goacnh.Fossil, error)
+	FossilGroupsFunc                     func() (map[string][]*goacnh.Fossil, error)
+	FossilGroupsContextFunc              func(context.Context) (map[string][]*goacnh.Fossil, error)
+	FossilImageDownloadFunc              func(*goacnh.Fossil, string) (string, error)
+	FossilImageDownloadContextFunc       func(context.Context, *goacnh.Fossil, string) (string, error)
+	FossilImageDownloadToFunc            func(*goacnh.Fossil, io.Writer) error
+	FossilImageDownloadToContextFunc     func(context.Context, *goacnh.Fossil, io.Writer) error
+	FossilListFunc                       func() ([]*goacnh.Fossil, error)
+	FossilListContextFunc                func(context.Context) ([]*goacnh.Fossil, error)
+	FossilsByGroupFunc                   func(string) ([]*goacnh.Fossil, error)
+	FossilsByGroupContextFunc            func(context.Context, string) ([]*goacnh.Fossil, error)
+	GetRawFunc                           func(context.Context, goacnh.Category, int) (json.RawMessage, error)
+	HemisphereFunc                       func() goacnh.Hemisphere
+	HousewareByNameFunc                  func(string) (*goacnh.HousewareItem, error)
+	HousewareByNameContextFunc           func(context.Context, string) (*goacnh.HousewareItem, error)
+	HousewareImagesDownloadFunc          func(*goacnh.HousewareItem, string) ([]string, error)
+	HousewareImagesDownloadContextFunc   func(context.Context, *goacnh.HousewareItem, string) ([]string, error)
+	HousewareListFunc                    func() ([]*goacnh.HousewareItem, error)
+	HousewareListContextFunc             func(context.Context) ([]*goacnh.HousewareItem, error)
+	IconDownloadFunc                     func(goacnh.Category, int, string) (string, error)
+	IconDownloadContextFunc              func(context.Context, goacnh.Category, int, string) (string, error)
+	IconDownloadToFunc                   func(goacnh.Category, int, io.Writer) error
+	IconDownloadToContextFunc            func(context.Context, goacnh.Category, int, io.Writer) error
+	IconURLFunc                          func(goacnh.Category, int) string
+	ImageDownloadFunc                    func(goacnh.Category, int, string) (string, error)
+	ImageDownloadContextFunc             func(context.Context, goacnh.Category, int, string) (string, error)
+	ImageDownloadToFunc                  func(goacnh.Category, int, io.Writer) error
+	ImageDownloadToContextFunc           func(context.Context, goacnh.Category, int, io.Writer) error
+	ImageURLFunc                         func(goacnh.Category, int) string
+	InvalidateCacheFunc                  func()
+	InvalidateDiskCacheFunc              func() error
+	ListSinceFunc                        func(context.Context, goacnh.Category, string) (*goacnh.ListResult, error)
+	LocalNameFunc                        func(map[string]string) string
+	MiscItemByNameFunc                   func(string) (*goacnh.MiscItem, error)
+	MiscItemByNameContextFunc            func(context.Context, string) (*goacnh.MiscItem, error)
+	MiscItemImagesDownloadFunc           func(*goacnh.MiscItem, string) ([]string, error)
+	MiscItemImagesDownloadContextFunc    func(context.Context, *goacnh.MiscItem, string) ([]string, error)
+	MiscItemListFunc                     func() ([]*goacnh.MiscItem, error)
+	MiscItemListContextFunc              func(context.Context) ([]*goacnh.MiscItem, error)
+	PlanDownloadAllBGMFunc               func(string) (*goacnh.DownloadPlan, error)
+	PlanDownloadAllBGMContextFunc        func(context.Context, string) (*goacnh.DownloadPlan, error)
+	PlanDownloadAllSongsFunc             func(string) (*goacnh.DownloadPlan, error)
+	PlanDownloadAllSongsContextFunc      func(context.Context, string) (*goacnh.DownloadPlan, error)
+	RecipeByNameFunc                     func(string) (*goacnh.Recipe, error)
+	RecipeByNameContextFunc              func(context.Context, string) (*goacnh.Recipe, error)
+	RecipeCraftedItemFunc                func(*goacnh.Recipe) ([]*goacnh.ItemVariant, error)
+	RecipeCraftedItemContextFunc         func(context.Context, *goacnh.Recipe) ([]*goacnh.ItemVariant, error)
+	RecipeListFunc                       func() ([]*goacnh.Recipe, error)
+	RecipeListContextFunc                func(context.Context) ([]*goacnh.Recipe, error)
+	RegisterCustomEntryFunc              func(*goacnh.CustomEntry) error
+	RemoveCustomEntryFunc                func(int)
+	SeaCreatureByIDFunc                  func(int) (*goacnh.SeaCreature, error)
+	SeaCreatureByIDContextFunc           func(context.Context, int) (*goacnh.SeaCreature, error)
+	SeaCreatureByNameFunc                func(string) (*goacnh.SeaCreature, error)
+	SeaCreatureByNameContextFunc         func(context.Context, string) (*goacnh.SeaCreature, error)
+	SeaCreatureIconDownloadFunc          func(int, string) (string, error)
+	SeaCreatureIconDownloadContextFunc   func(context.Context, int, string) (string, error)
+	SeaCreatureImageDownloadFunc         func(int, string) (string, error)
+	SeaCreatureImageDownloadContextFunc  func(context.Context, int, string) (string, error)
+	SeaCreatureListFunc                  func() ([]*goacnh.SeaCreature, error)
+	SeaCreatureListContextFunc           func(context.Context) ([]*goacnh.SeaCreature, error)
+	SeaCreaturesByIDsFunc                func([]int) ([]*goacnh.SeaCreature, error)
+	SeaCreaturesByIDsContextFunc         func(context.Context, []int) ([]*goacnh.SeaCreature, error)
+	SnapshotVersionFunc                  func() (*goacnh.SnapshotVersion, error)
+	SongByExternalIDFunc                 func(goacnh.IDTranslator, string) (*goacnh.Song, error)
+	SongByExternalIDContextFunc          func(context.Context, goacnh.IDTranslator, string) (*goacnh.Song, error)
+	SongByIDFunc                         func(int) (*goacnh.Song, error)
+	SongByIDContextFunc                  func(context.Context, int) (*goacnh.Song, error)
+	SongByNameFunc                       func(string) (*goacnh.Song, error)
+	SongByNameContextFunc                func(context.Context, string) (*goacnh.Song, error)
+	SongDownloadFunc                     func(*goacnh.Song, string) (string, error)
+	SongDownloadContextFunc              func(context.Context, *goacnh.Song, string) (string, error)
+	SongDownloadTempFunc                 func(*goacnh.Song) (string, error)
+	SongDownloadTempContextFunc          func(context.Context, *goacnh.Song) (string, error)
+	SongDownloadToFunc                   func(*goacnh.Song, io.Writer) error
+	SongDownloadToContextFunc            func(context.Context, *goacnh.Song, io.Writer) error
+	SongListFunc                         func() ([]*goacnh.Song, error)
+	SongListContextFunc                  func(context.Context) ([]*goacnh.Song, error)
+	SongURLFunc                          func(*goacnh.Song) string
+	SongsByIDsFunc                       func([]int) ([]*goacnh.Song, error)
+	SongsByIDsContextFunc                func(context.Context, []int) ([]*goacnh.Song, error)
+	TimeFormatterFunc                    func(bool) goacnh.TimeFormatter
+	UseFunc                              func(...goacnh.Middleware)
+	VariantByIDFunc                      func(int) (*goacnh.ItemVariant, error)
+	VariantByIDContextFunc               func(context.Context, int) (*goacnh.ItemVariant, error)
+	VariantImageDownloadFunc             func(*goacnh.ItemVariant, string) (string, error)
+	VariantImageDownloadContextFunc      func(context.Context, *goacnh.ItemVariant, string) (string, error)
+	VariantImageDownloadToFunc           func(*goacnh.ItemVariant, io.Writer) error
+	VariantImageDownloadToContextFunc    func(context.Context, *goacnh.ItemVariant, io.Writer) error
+	VariantImagesDownloadFunc            func(string, []*goacnh.ItemVariant, string) ([]string, error)
+	VariantImagesDownloadContextFunc     func(context.Context, string, []*goacnh.ItemVariant, string) ([]string, error)
+	VillagerIconDownloadFunc             func(int, string) (string, error)
+	VillagerIconDownloadContextFunc      func(context.Context, int, string) (string, error)
+	VillagerIconDownloadToFunc           func(int, io.Writer) error
+	VillagerIconDownloadToContextFunc    func(context.Context, int, io.Writer) error
+	VillagerImageDownloadFunc            func(int, string) (string, error)
+	VillagerImageDownloadContextFunc     func(context.Context, int, string) (string, error)
+	VillagerImageDownloadToFunc          func(int, io.Writer) error
+	VillagerImageDownloadToContextFunc   func(context.Context, int, io.Writer) error
+	WallMountedByNameFunc                func(string) (*goacnh.WallMountedItem, error)
+	WallMountedByNameContextFunc         func(context.Context, string) (*goacnh.WallMountedItem, error)
+	WallMountedImagesDownloadFunc        func(*goacnh.WallMountedItem, string) ([]string, error)
+	WallMountedImagesDownloadContextFunc func(context.Context, *goacnh.WallMountedItem, string) ([]string, error)
+	WallMountedListFunc                  func() ([]*goacnh.WallMountedItem, error)
+	WallMountedListContextFunc           func(context.Context) ([]*goacnh.WallMountedItem, error)
+	WriteSnapshotFunc                    func(context.Context, string) error
+}
+
+var _ goacnh.ACNHClient = (*Client)(nil)
+
+// ArtByID calls ArtByIDFunc.
+func (m *Client) ArtByID(id int) (*goacnh.Art, error) {
+	if m.ArtByIDFunc != nil {
+		return m.ArtByIDFunc(id)
+	}
+	return m.ArtByIDContext(context.Background(), id)
+}
+
+// ArtByIDContext calls ArtByIDContextFunc.
+func (m *Client) ArtByIDContext(ctx context.Context, id int) (*goacnh.Art, error) {
+	if m.ArtByIDContextFunc != nil {
+		return m.ArtByIDContextFunc(ctx, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// ArtByIDs calls ArtByIDsFunc.
+func (m *Client) ArtByIDs(ids []int) ([]*goacnh.Art, error) {
+	if m.ArtByIDsFunc != nil {
+		return m.ArtByIDsFunc(ids)
+	}
+	return m.ArtByIDsContext(context.Background(), ids)
+}
+
+// ArtByIDsContext calls ArtByIDsContextFunc.
+func (m *Client) ArtByIDsContext(ctx context.Context, ids []int) ([]*goacnh.Art, error) {
+	if m.ArtByIDsContextFunc != nil {
+		return m.ArtByIDsContextFunc(ctx, ids)
+	}
+	return nil, ErrNotMocked
+}
+
+// ArtByName calls ArtByNameFunc.
+func (m *Client) ArtByName(name string) (*goacnh.Art, error) {
+	if m.ArtByNameFunc != nil {
+		return m.ArtByNameFunc(name)
+	}
+	return m.ArtByNameContext(context.Background(), name)
+}
+
+// ArtByNameContext calls ArtByNameContextFunc.
+func (m *Client) ArtByNameContext(ctx context.Context, name string) (*goacnh.Art, error) {
+	if m.ArtByNameContextFunc != nil {
+		return m.ArtByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// ArtImageDownload calls ArtImageDownloadFunc.
+func (m *Client) ArtImageDownload(art *goacnh.Art, downloadDirectory string) (string, error) {
+	if m.ArtImageDownloadFunc != nil {
+		return m.ArtImageDownloadFunc(art, downloadDirectory)
+	}
+	return m.ArtImageDownloadContext(context.Background(), art, downloadDirectory)
+}
+
+// ArtImageDownloadContext calls ArtImageDownloadContextFunc.
+func (m *Client) ArtImageDownloadContext(ctx context.Context, art *goacnh.Art, downloadDirectory string) (string, error) {
+	if m.ArtImageDownloadContextFunc != nil {
+		return m.ArtImageDownloadContextFunc(ctx, art, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// ArtImageDownloadTo calls ArtImageDownloadToFunc.
+func (m *Client) ArtImageDownloadTo(art *goacnh.Art, w io.Writer) error {
+	if m.ArtImageDownloadToFunc != nil {
+		return m.ArtImageDownloadToFunc(art, w)
+	}
+	return m.ArtImageDownloadToContext(context.Background(), art, w)
+}
+
+// ArtImageDownloadToContext calls ArtImageDownloadToContextFunc.
+func (m *Client) ArtImageDownloadToContext(ctx context.Context, art *goacnh.Art, w io.Writer) error {
+	if m.ArtImageDownloadToContextFunc != nil {
+		return m.ArtImageDownloadToContextFunc(ctx, art, w)
+	}
+	return ErrNotMocked
+}
+
+// ArtList calls ArtListFunc.
+func (m *Client) ArtList() ([]*goacnh.Art, error) {
+	if m.ArtListFunc != nil {
+		return m.ArtListFunc()
+	}
+	return m.ArtListContext(context.Background())
+}
+
+// ArtListContext calls ArtListContextFunc.
+func (m *Client) ArtListContext(ctx context.Context) ([]*goacnh.Art, error) {
+	if m.ArtListContextFunc != nil {
+		return m.ArtListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// AssetURL calls AssetURLFunc.
+func (m *Client) AssetURL(uri string) string {
+	if m.AssetURLFunc != nil {
+		return m.AssetURLFunc(uri)
+	}
+	return ""
+}
+
+// BGMDownload calls BGMDownloadFunc.
+func (m *Client) BGMDownload(track *goacnh.BGMTrack, downloadDirectory string) (string, error) {
+	if m.BGMDownloadFunc != nil {
+		return m.BGMDownloadFunc(track, downloadDirectory)
+	}
+	return m.BGMDownloadContext(context.Background(), track, downloadDirectory)
+}
+
+// BGMDownloadContext calls BGMDownloadContextFunc.
+func (m *Client) BGMDownloadContext(ctx context.Context, track *goacnh.BGMTrack, downloadDirectory string) (string, error) {
+	if m.BGMDownloadContextFunc != nil {
+		return m.BGMDownloadContextFunc(ctx, track, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// BGMDownloadTemp calls BGMDownloadTempFunc.
+func (m *Client) BGMDownloadTemp(track *goacnh.BGMTrack) (string, error) {
+	if m.BGMDownloadTempFunc != nil {
+		return m.BGMDownloadTempFunc(track)
+	}
+	return m.BGMDownloadTempContext(context.Background(), track)
+}
+
+// BGMDownloadTempContext calls BGMDownloadTempContextFunc.
+func (m *Client) BGMDownloadTempContext(ctx context.Context, track *goacnh.BGMTrack) (string, error) {
+	if m.BGMDownloadTempContextFunc != nil {
+		return m.BGMDownloadTempContextFunc(ctx, track)
+	}
+	return "", ErrNotMocked
+}
+
+// BGMDownloadTo calls BGMDownloadToFunc.
+func (m *Client) BGMDownloadTo(track *goacnh.BGMTrack, w io.Writer) error {
+	if m.BGMDownloadToFunc != nil {
+		return m.BGMDownloadToFunc(track, w)
+	}
+	return m.BGMDownloadToContext(context.Background(), track, w)
+}
+
+// BGMDownloadToContext calls BGMDownloadToContextFunc.
+func (m *Client) BGMDownloadToContext(ctx context.Context, track *goacnh.BGMTrack, w io.Writer) error {
+	if m.BGMDownloadToContextFunc != nil {
+		return m.BGMDownloadToContextFunc(ctx, track, w)
+	}
+	return ErrNotMocked
+}
+
+// BGMList calls BGMListFunc.
+func (m *Client) BGMList() ([]*goacnh.BGMTrack, error) {
+	if m.BGMListFunc != nil {
+		return m.BGMListFunc()
+	}
+	return m.BGMListContext(context.Background())
+}
+
+// BGMListByHour calls BGMListByHourFunc.
+func (m *Client) BGMListByHour(hour int) ([]*goacnh.BGMTrack, error) {
+	if m.BGMListByHourFunc != nil {
+		return m.BGMListByHourFunc(hour)
+	}
+	return m.BGMListByHourContext(context.Background(), hour)
+}
+
+// BGMListByHourContext calls BGMListByHourContextFunc.
+func (m *Client) BGMListByHourContext(ctx context.Context, hour int) ([]*goacnh.BGMTrack, error) {
+	if m.BGMListByHourContextFunc != nil {
+		return m.BGMListByHourContextFunc(ctx, hour)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMListByWeather calls BGMListByWeatherFunc.
+func (m *Client) BGMListByWeather(weather goacnh.Weather) ([]*goacnh.BGMTrack, error) {
+	if m.BGMListByWeatherFunc != nil {
+		return m.BGMListByWeatherFunc(weather)
+	}
+	return m.BGMListByWeatherContext(context.Background(), weather)
+}
+
+// BGMListByWeatherContext calls BGMListByWeatherContextFunc.
+func (m *Client) BGMListByWeatherContext(ctx context.Context, weather goacnh.Weather) ([]*goacnh.BGMTrack, error) {
+	if m.BGMListByWeatherContextFunc != nil {
+		return m.BGMListByWeatherContextFunc(ctx, weather)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMListContext calls BGMListContextFunc.
+func (m *Client) BGMListContext(ctx context.Context) ([]*goacnh.BGMTrack, error) {
+	if m.BGMListContextFunc != nil {
+		return m.BGMListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMReport calls BGMReportFunc.
+func (m *Client) BGMReport() (*goacnh.BGMReport, error) {
+	if m.BGMReportFunc != nil {
+		return m.BGMReportFunc()
+	}
+	return m.BGMReportContext(context.Background())
+}
+
+// BGMReportContext calls BGMReportContextFunc.
+func (m *Client) BGMReportContext(ctx context.Context) (*goacnh.BGMReport, error) {
+	if m.BGMReportContextFunc != nil {
+		return m.BGMReportContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMTrackByExternalID calls BGMTrackByExternalIDFunc.
+func (m *Client) BGMTrackByExternalID(translator goacnh.IDTranslator, externalID string) (*goacnh.BGMTrack, error) {
+	if m.BGMTrackByExternalIDFunc != nil {
+		return m.BGMTrackByExternalIDFunc(translator, externalID)
+	}
+	return m.BGMTrackByExternalIDContext(context.Background(), translator, externalID)
+}
+
+// BGMTrackByExternalIDContext calls BGMTrackByExternalIDContextFunc.
+func (m *Client) BGMTrackByExternalIDContext(ctx context.Context, translator goacnh.IDTranslator, externalID string) (*goacnh.BGMTrack, error) {
+	if m.BGMTrackByExternalIDContextFunc != nil {
+		return m.BGMTrackByExternalIDContextFunc(ctx, translator, externalID)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMTrackByID calls BGMTrackByIDFunc.
+func (m *Client) BGMTrackByID(id int) (*goacnh.BGMTrack, error) {
+	if m.BGMTrackByIDFunc != nil {
+		return m.BGMTrackByIDFunc(id)
+	}
+	return m.BGMTrackByIDContext(context.Background(), id)
+}
+
+// BGMTrackByIDContext calls BGMTrackByIDContextFunc.
+func (m *Client) BGMTrackByIDContext(ctx context.Context, id int) (*goacnh.BGMTrack, error) {
+	if m.BGMTrackByIDContextFunc != nil {
+		return m.BGMTrackByIDContextFunc(ctx, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMTrackByQuery calls BGMTrackByQueryFunc.
+func (m *Client) BGMTrackByQuery(hour int, weather goacnh.Weather) (*goacnh.BGMTrack, error) {
+	if m.BGMTrackByQueryFunc != nil {
+		return m.BGMTrackByQueryFunc(hour, weather)
+	}
+	return m.BGMTrackByQueryContext(context.Background(), hour, weather)
+}
+
+// BGMTrackByQueryContext calls BGMTrackByQueryContextFunc.
+func (m *Client) BGMTrackByQueryContext(ctx context.Context, hour int, weather goacnh.Weather) (*goacnh.BGMTrack, error) {
+	if m.BGMTrackByQueryContextFunc != nil {
+		return m.BGMTrackByQueryContextFunc(ctx, hour, weather)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMTracksByIDs calls BGMTracksByIDsFunc.
+func (m *Client) BGMTracksByIDs(ids []int) ([]*goacnh.BGMTrack, error) {
+	if m.BGMTracksByIDsFunc != nil {
+		return m.BGMTracksByIDsFunc(ids)
+	}
+	return m.BGMTracksByIDsContext(context.Background(), ids)
+}
+
+// BGMTracksByIDsContext calls BGMTracksByIDsContextFunc.
+func (m *Client) BGMTracksByIDsContext(ctx context.Context, ids []int) ([]*goacnh.BGMTrack, error) {
+	if m.BGMTracksByIDsContextFunc != nil {
+		return m.BGMTracksByIDsContextFunc(ctx, ids)
+	}
+	return nil, ErrNotMocked
+}
+
+// BGMURL calls BGMURLFunc.
+func (m *Client) BGMURL(track *goacnh.BGMTrack) string {
+	if m.BGMURLFunc != nil {
+		return m.BGMURLFunc(track)
+	}
+	return ""
+}
+
+// BugIconDownload calls BugIconDownloadFunc.
+func (m *Client) BugIconDownload(id int, downloadDirectory string) (string, error) {
+	if m.BugIconDownloadFunc != nil {
+		return m.BugIconDownloadFunc(id, downloadDirectory)
+	}
+	return m.BugIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// BugIconDownloadContext calls BugIconDownloadContextFunc.
+func (m *Client) BugIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.BugIconDownloadContextFunc != nil {
+		return m.BugIconDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// BugImageDownload calls BugImageDownloadFunc.
+func (m *Client) BugImageDownload(id int, downloadDirectory string) (string, error) {
+	if m.BugImageDownloadFunc != nil {
+		return m.BugImageDownloadFunc(id, downloadDirectory)
+	}
+	return m.BugImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// BugImageDownloadContext calls BugImageDownloadContextFunc.
+func (m *Client) BugImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.BugImageDownloadContextFunc != nil {
+		return m.BugImageDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// Capabilities calls CapabilitiesFunc.
+func (m *Client) Capabilities() *goacnh.Capabilities {
+	if m.CapabilitiesFunc != nil {
+		return m.CapabilitiesFunc()
+	}
+	return nil
+}
+
+// CategoryJSON calls CategoryJSONFunc.
+func (m *Client) CategoryJSON(ctx context.Context, category goacnh.Category) ([]byte, error) {
+	if m.CategoryJSONFunc != nil {
+		return m.CategoryJSONFunc(ctx, category)
+	}
+	return nil, ErrNotMocked
+}
+
+// CheckIntegrity calls CheckIntegrityFunc.
+func (m *Client) CheckIntegrity() (*goacnh.IntegrityReport, error) {
+	if m.CheckIntegrityFunc != nil {
+		return m.CheckIntegrityFunc()
+	}
+	return m.CheckIntegrityContext(context.Background())
+}
+
+// CheckIntegrityContext calls CheckIntegrityContextFunc.
+func (m *Client) CheckIntegrityContext(ctx context.Context) (*goacnh.IntegrityReport, error) {
+	if m.CheckIntegrityContextFunc != nil {
+		return m.CheckIntegrityContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// CoverageReport calls CoverageReportFunc.
+func (m *Client) CoverageReport(ctx context.Context) (*goacnh.CoverageReport, error) {
+	if m.CoverageReportFunc != nil {
+		return m.CoverageReportFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// CustomEntries calls CustomEntriesFunc.
+func (m *Client) CustomEntries(kinds ...goacnh.Category) []*goacnh.CustomEntry {
+	if m.CustomEntriesFunc != nil {
+		return m.CustomEntriesFunc(kinds...)
+	}
+	return nil
+}
+
+// CustomEntryByID calls CustomEntryByIDFunc.
+func (m *Client) CustomEntryByID(id int) (*goacnh.CustomEntry, error) {
+	if m.CustomEntryByIDFunc != nil {
+		return m.CustomEntryByIDFunc(id)
+	}
+	return nil, ErrNotMocked
+}
+
+// CustomEntryByName calls CustomEntryByNameFunc.
+func (m *Client) CustomEntryByName(name string) (*goacnh.CustomEntry, error) {
+	if m.CustomEntryByNameFunc != nil {
+		return m.CustomEntryByNameFunc(name)
+	}
+	return nil, ErrNotMocked
+}
+
+// DownloadAllBGM calls DownloadAllBGMFunc.
+func (m *Client) DownloadAllBGM(downloadDirectory string) ([]*goacnh.BGMDownloadResult, error) {
+	if m.DownloadAllBGMFunc != nil {
+		return m.DownloadAllBGMFunc(downloadDirectory)
+	}
+	return m.DownloadAllBGMContext(context.Background(), downloadDirectory)
+}
+
+// DownloadAllBGMContext calls DownloadAllBGMContextFunc.
+func (m *Client) DownloadAllBGMContext(ctx context.Context, downloadDirectory string) ([]*goacnh.BGMDownloadResult, error) {
+	if m.DownloadAllBGMContextFunc != nil {
+		return m.DownloadAllBGMContextFunc(ctx, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// DownloadAllSongs calls DownloadAllSongsFunc.
+func (m *Client) DownloadAllSongs(downloadDirectory string) ([]*goacnh.SongDownloadResult, error) {
+	if m.DownloadAllSongsFunc != nil {
+		return m.DownloadAllSongsFunc(downloadDirectory)
+	}
+	return m.DownloadAllSongsContext(context.Background(), downloadDirectory)
+}
+
+// DownloadAllSongsContext calls DownloadAllSongsContextFunc.
+func (m *Client) DownloadAllSongsContext(ctx context.Context, downloadDirectory string) ([]*goacnh.SongDownloadResult, error) {
+	if m.DownloadAllSongsContextFunc != nil {
+		return m.DownloadAllSongsContextFunc(ctx, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// FishByExternalID calls FishByExternalIDFunc.
+func (m *Client) FishByExternalID(translator goacnh.IDTranslator, externalID string) (*goacnh.Fish, error) {
+	if m.FishByExternalIDFunc != nil {
+		return m.FishByExternalIDFunc(translator, externalID)
+	}
+	return m.FishByExternalIDContext(context.Background(), translator, externalID)
+}
+
+// FishByExternalIDContext calls FishByExternalIDContextFunc.
+func (m *Client) FishByExternalIDContext(ctx context.Context, translator goacnh.IDTranslator, externalID string) (*goacnh.Fish, error) {
+	if m.FishByExternalIDContextFunc != nil {
+		return m.FishByExternalIDContextFunc(ctx, translator, externalID)
+	}
+	return nil, ErrNotMocked
+}
+
+// FishByID calls FishByIDFunc.
+func (m *Client) FishByID(id int) (*goacnh.Fish, error) {
+	if m.FishByIDFunc != nil {
+		return m.FishByIDFunc(id)
+	}
+	return m.FishByIDContext(context.Background(), id)
+}
+
+// FishByIDContext calls FishByIDContextFunc.
+func (m *Client) FishByIDContext(ctx context.Context, id int) (*goacnh.Fish, error) {
+	if m.FishByIDContextFunc != nil {
+		return m.FishByIDContextFunc(ctx, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// FishByIDs calls FishByIDsFunc.
+func (m *Client) FishByIDs(ids []int) ([]*goacnh.Fish, error) {
+	if m.FishByIDsFunc != nil {
+		return m.FishByIDsFunc(ids)
+	}
+	return m.FishByIDsContext(context.Background(), ids)
+}
+
+// FishByIDsContext calls FishByIDsContextFunc.
+func (m *Client) FishByIDsContext(ctx context.Context, ids []int) ([]*goacnh.Fish, error) {
+	if m.FishByIDsContextFunc != nil {
+		return m.FishByIDsContextFunc(ctx, ids)
+	}
+	return nil, ErrNotMocked
+}
+
+// FishByName calls FishByNameFunc.
+func (m *Client) FishByName(name string) (*goacnh.Fish, error) {
+	if m.FishByNameFunc != nil {
+		return m.FishByNameFunc(name)
+	}
+	return m.FishByNameContext(context.Background(), name)
+}
+
+// FishByNameContext calls FishByNameContextFunc.
+func (m *Client) FishByNameContext(ctx context.Context, name string) (*goacnh.Fish, error) {
+	if m.FishByNameContextFunc != nil {
+		return m.FishByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// FishIconDownload calls FishIconDownloadFunc.
+func (m *Client) FishIconDownload(id int, downloadDirectory string) (string, error) {
+	if m.FishIconDownloadFunc != nil {
+		return m.FishIconDownloadFunc(id, downloadDirectory)
+	}
+	return m.FishIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// FishIconDownloadContext calls FishIconDownloadContextFunc.
+func (m *Client) FishIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.FishIconDownloadContextFunc != nil {
+		return m.FishIconDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// FishImageDownload calls FishImageDownloadFunc.
+func (m *Client) FishImageDownload(id int, downloadDirectory string) (string, error) {
+	if m.FishImageDownloadFunc != nil {
+		return m.FishImageDownloadFunc(id, downloadDirectory)
+	}
+	return m.FishImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// FishImageDownloadContext calls FishImageDownloadContextFunc.
+func (m *Client) FishImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.FishImageDownloadContextFunc != nil {
+		return m.FishImageDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// FishList calls FishListFunc.
+func (m *Client) FishList() ([]*goacnh.Fish, error) {
+	if m.FishListFunc != nil {
+		return m.FishListFunc()
+	}
+	return m.FishListContext(context.Background())
+}
+
+// FishListContext calls FishListContextFunc.
+func (m *Client) FishListContext(ctx context.Context) ([]*goacnh.Fish, error) {
+	if m.FishListContextFunc != nil {
+		return m.FishListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// FossilByName calls FossilByNameFunc.
+func (m *Client) FossilByName(name string) (*goacnh.Fossil, error) {
+	if m.FossilByNameFunc != nil {
+		return m.FossilByNameFunc(name)
+	}
+	return m.FossilByNameContext(context.Background(), name)
+}
+
+// FossilByNameContext calls FossilByNameContextFunc.
+func (m *Client) FossilByNameContext(ctx context.Context, name string) (*goacnh.Fossil, error) {
+	if m.FossilByNameContextFunc != nil {
+		return m.FossilByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// FossilGroups calls FossilGroupsFunc.
+func (m *Client) FossilGroups() (map[string][]*goacnh.Fossil, error) {
+	if m.FossilGroupsFunc != nil {
+		return m.FossilGroupsFunc()
+	}
+	return m.FossilGroupsContext(context.Background())
+}
+
+// FossilGroupsContext calls FossilGroupsContextFunc.
+func (m *Client) FossilGroupsContext(ctx context.Context) (map[string][]*goacnh.Fossil, error) {
+	if m.FossilGroupsContextFunc != nil {
+		return m.FossilGroupsContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// FossilImageDownload calls FossilImageDownloadFunc.
+func (m *Client) FossilImageDownload(fossil *goacnh.Fossil, downloadDirectory string) (string, error) {
+	if m.FossilImageDownloadFunc != nil {
+		return m.FossilImageDownloadFunc(fossil, downloadDirectory)
+	}
+	return m.FossilImageDownloadContext(context.Background(), fossil, downloadDirectory)
+}
+
+// FossilImageDownloadContext calls FossilImageDownloadContextFunc.
+func (m *Client) FossilImageDownloadContext(ctx context.Context, fossil *goacnh.Fossil, downloadDirectory string) (string, error) {
+	if m.FossilImageDownloadContextFunc != nil {
+		return m.FossilImageDownloadContextFunc(ctx, fossil, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// FossilImageDownloadTo calls FossilImageDownloadToFunc.
+func (m *Client) FossilImageDownloadTo(fossil *goacnh.Fossil, w io.Writer) error {
+	if m.FossilImageDownloadToFunc != nil {
+		return m.FossilImageDownloadToFunc(fossil, w)
+	}
+	return m.FossilImageDownloadToContext(context.Background(), fossil, w)
+}
+
+// FossilImageDownloadToContext calls FossilImageDownloadToContextFunc.
+func (m *Client) FossilImageDownloadToContext(ctx context.Context, fossil *goacnh.Fossil, w io.Writer) error {
+	if m.FossilImageDownloadToContextFunc != nil {
+		return m.FossilImageDownloadToContextFunc(ctx, fossil, w)
+	}
+	return ErrNotMocked
+}
+
+// FossilList calls FossilListFunc.
+func (m *Client) FossilList() ([]*goacnh.Fossil, error) {
+	if m.FossilListFunc != nil {
+		return m.FossilListFunc()
+	}
+	return m.FossilListContext(context.Background())
+}
+
+// FossilListContext calls FossilListContextFunc.
+func (m *Client) FossilListContext(ctx context.Context) ([]*goacnh.Fossil, error) {
+	if m.FossilListContextFunc != nil {
+		return m.FossilListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// FossilsByGroup calls FossilsByGroupFunc.
+func (m *Client) FossilsByGroup(group string) ([]*goacnh.Fossil, error) {
+	if m.FossilsByGroupFunc != nil {
+		return m.FossilsByGroupFunc(group)
+	}
+	return m.FossilsByGroupContext(context.Background(), group)
+}
+
+// FossilsByGroupContext calls FossilsByGroupContextFunc.
+func (m *Client) FossilsByGroupContext(ctx context.Context, group string) ([]*goacnh.Fossil, error) {
+	if m.FossilsByGroupContextFunc != nil {
+		return m.FossilsByGroupContextFunc(ctx, group)
+	}
+	return nil, ErrNotMocked
+}
+
+// GetRaw calls GetRawFunc.
+func (m *Client) GetRaw(ctx context.Context, category goacnh.Category, id int) (json.RawMessage, error) {
+	if m.GetRawFunc != nil {
+		return m.GetRawFunc(ctx, category, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// Hemisphere calls HemisphereFunc.
+func (m *Client) Hemisphere() goacnh.Hemisphere {
+	if m.HemisphereFunc != nil {
+		return m.HemisphereFunc()
+	}
+	return goacnh.NorthernHemisphere
+}
+
+// HousewareByName calls HousewareByNameFunc.
+func (m *Client) HousewareByName(name string) (*goacnh.HousewareItem, error) {
+	if m.HousewareByNameFunc != nil {
+		return m.HousewareByNameFunc(name)
+	}
+	return m.HousewareByNameContext(context.Background(), name)
+}
+
+// HousewareByNameContext calls HousewareByNameContextFunc.
+func (m *Client) HousewareByNameContext(ctx context.Context, name string) (*goacnh.HousewareItem, error) {
+	if m.HousewareByNameContextFunc != nil {
+		return m.HousewareByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// HousewareImagesDownload calls HousewareImagesDownloadFunc.
+func (m *Client) HousewareImagesDownload(item *goacnh.HousewareItem, downloadDirectory string) ([]string, error) {
+	if m.HousewareImagesDownloadFunc != nil {
+		return m.HousewareImagesDownloadFunc(item, downloadDirectory)
+	}
+	return m.HousewareImagesDownloadContext(context.Background(), item, downloadDirectory)
+}
+
+// HousewareImagesDownloadContext calls HousewareImagesDownloadContextFunc.
+func (m *Client) HousewareImagesDownloadContext(ctx context.Context, item *goacnh.HousewareItem, downloadDirectory string) ([]string, error) {
+	if m.HousewareImagesDownloadContextFunc != nil {
+		return m.HousewareImagesDownloadContextFunc(ctx, item, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// HousewareList calls HousewareListFunc.
+func (m *Client) HousewareList() ([]*goacnh.HousewareItem, error) {
+	if m.HousewareListFunc != nil {
+		return m.HousewareListFunc()
+	}
+	return m.HousewareListContext(context.Background())
+}
+
+// HousewareListContext calls HousewareListContextFunc.
+func (m *Client) HousewareListContext(ctx context.Context) ([]*goacnh.HousewareItem, error) {
+	if m.HousewareListContextFunc != nil {
+		return m.HousewareListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// IconDownload calls IconDownloadFunc.
+func (m *Client) IconDownload(category goacnh.Category, id int, downloadDirectory string) (string, error) {
+	if m.IconDownloadFunc != nil {
+		return m.IconDownloadFunc(category, id, downloadDirectory)
+	}
+	return m.IconDownloadContext(context.Background(), category, id, downloadDirectory)
+}
+
+// IconDownloadContext calls IconDownloadContextFunc.
+func (m *Client) IconDownloadContext(ctx context.Context, category goacnh.Category, id int, downloadDirectory string) (string, error) {
+	if m.IconDownloadContextFunc != nil {
+		return m.IconDownloadContextFunc(ctx, category, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// IconDownloadTo calls IconDownloadToFunc.
+func (m *Client) IconDownloadTo(category goacnh.Category, id int, w io.Writer) error {
+	if m.IconDownloadToFunc != nil {
+		return m.IconDownloadToFunc(category, id, w)
+	}
+	return m.IconDownloadToContext(context.Background(), category, id, w)
+}
+
+// IconDownloadToContext calls IconDownloadToContextFunc.
+func (m *Client) IconDownloadToContext(ctx context.Context, category goacnh.Category, id int, w io.Writer) error {
+	if m.IconDownloadToContextFunc != nil {
+		return m.IconDownloadToContextFunc(ctx, category, id, w)
+	}
+	return ErrNotMocked
+}
+
+// IconURL calls IconURLFunc.
+func (m *Client) IconURL(category goacnh.Category, id int) string {
+	if m.IconURLFunc != nil {
+		return m.IconURLFunc(category, id)
+	}
+	return ""
+}
+
+// ImageDownload calls ImageDownloadFunc.
+func (m *Client) ImageDownload(category goacnh.Category, id int, downloadDirectory string) (string, error) {
+	if m.ImageDownloadFunc != nil {
+		return m.ImageDownloadFunc(category, id, downloadDirectory)
+	}
+	return m.ImageDownloadContext(context.Background(), category, id, downloadDirectory)
+}
+
+// ImageDownloadContext calls ImageDownloadContextFunc.
+func (m *Client) ImageDownloadContext(ctx context.Context, category goacnh.Category, id int, downloadDirectory string) (string, error) {
+	if m.ImageDownloadContextFunc != nil {
+		return m.ImageDownloadContextFunc(ctx, category, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// ImageDownloadTo calls ImageDownloadToFunc.
+func (m *Client) ImageDownloadTo(category goacnh.Category, id int, w io.Writer) error {
+	if m.ImageDownloadToFunc != nil {
+		return m.ImageDownloadToFunc(category, id, w)
+	}
+	return m.ImageDownloadToContext(context.Background(), category, id, w)
+}
+
+// ImageDownloadToContext calls ImageDownloadToContextFunc.
+func (m *Client) ImageDownloadToContext(ctx context.Context, category goacnh.Category, id int, w io.Writer) error {
+	if m.ImageDownloadToContextFunc != nil {
+		return m.ImageDownloadToContextFunc(ctx, category, id, w)
+	}
+	return ErrNotMocked
+}
+
+// ImageURL calls ImageURLFunc.
+func (m *Client) ImageURL(category goacnh.Category, id int) string {
+	if m.ImageURLFunc != nil {
+		return m.ImageURLFunc(category, id)
+	}
+	return ""
+}
+
+// InvalidateCache calls InvalidateCacheFunc.
+func (m *Client) InvalidateCache() {
+	if m.InvalidateCacheFunc != nil {
+		m.InvalidateCacheFunc()
+		return
+	}
+}
+
+// InvalidateDiskCache calls InvalidateDiskCacheFunc.
+func (m *Client) InvalidateDiskCache() error {
+	if m.InvalidateDiskCacheFunc != nil {
+		return m.InvalidateDiskCacheFunc()
+	}
+	return ErrNotMocked
+}
+
+// ListSince calls ListSinceFunc.
+func (m *Client) ListSince(ctx context.Context, category goacnh.Category, etag string) (*goacnh.ListResult, error) {
+	if m.ListSinceFunc != nil {
+		return m.ListSinceFunc(ctx, category, etag)
+	}
+	return nil, ErrNotMocked
+}
+
+// LocalName calls LocalNameFunc.
+func (m *Client) LocalName(names map[string]string) string {
+	if m.LocalNameFunc != nil {
+		return m.LocalNameFunc(names)
+	}
+	return ""
+}
+
+// MiscItemByName calls MiscItemByNameFunc.
+func (m *Client) MiscItemByName(name string) (*goacnh.MiscItem, error) {
+	if m.MiscItemByNameFunc != nil {
+		return m.MiscItemByNameFunc(name)
+	}
+	return m.MiscItemByNameContext(context.Background(), name)
+}
+
+// MiscItemByNameContext calls MiscItemByNameContextFunc.
+func (m *Client) MiscItemByNameContext(ctx context.Context, name string) (*goacnh.MiscItem, error) {
+	if m.MiscItemByNameContextFunc != nil {
+		return m.MiscItemByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// MiscItemImagesDownload calls MiscItemImagesDownloadFunc.
+func (m *Client) MiscItemImagesDownload(item *goacnh.MiscItem, downloadDirectory string) ([]string, error) {
+	if m.MiscItemImagesDownloadFunc != nil {
+		return m.MiscItemImagesDownloadFunc(item, downloadDirectory)
+	}
+	return m.MiscItemImagesDownloadContext(context.Background(), item, downloadDirectory)
+}
+
+// MiscItemImagesDownloadContext calls MiscItemImagesDownloadContextFunc.
+func (m *Client) MiscItemImagesDownloadContext(ctx context.Context, item *goacnh.MiscItem, downloadDirectory string) ([]string, error) {
+	if m.MiscItemImagesDownloadContextFunc != nil {
+		return m.MiscItemImagesDownloadContextFunc(ctx, item, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// MiscItemList calls MiscItemListFunc.
+func (m *Client) MiscItemList() ([]*goacnh.MiscItem, error) {
+	if m.MiscItemListFunc != nil {
+		return m.MiscItemListFunc()
+	}
+	return m.MiscItemListContext(context.Background())
+}
+
+// MiscItemListContext calls MiscItemListContextFunc.
+func (m *Client) MiscItemListContext(ctx context.Context) ([]*goacnh.MiscItem, error) {
+	if m.MiscItemListContextFunc != nil {
+		return m.MiscItemListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// PlanDownloadAllBGM calls PlanDownloadAllBGMFunc.
+func (m *Client) PlanDownloadAllBGM(downloadDirectory string) (*goacnh.DownloadPlan, error) {
+	if m.PlanDownloadAllBGMFunc != nil {
+		return m.PlanDownloadAllBGMFunc(downloadDirectory)
+	}
+	return m.PlanDownloadAllBGMContext(context.Background(), downloadDirectory)
+}
+
+// PlanDownloadAllBGMContext calls PlanDownloadAllBGMContextFunc.
+func (m *Client) PlanDownloadAllBGMContext(ctx context.Context, downloadDirectory string) (*goacnh.DownloadPlan, error) {
+	if m.PlanDownloadAllBGMContextFunc != nil {
+		return m.PlanDownloadAllBGMContextFunc(ctx, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// PlanDownloadAllSongs calls PlanDownloadAllSongsFunc.
+func (m *Client) PlanDownloadAllSongs(downloadDirectory string) (*goacnh.DownloadPlan, error) {
+	if m.PlanDownloadAllSongsFunc != nil {
+		return m.PlanDownloadAllSongsFunc(downloadDirectory)
+	}
+	return m.PlanDownloadAllSongsContext(context.Background(), downloadDirectory)
+}
+
+// PlanDownloadAllSongsContext calls PlanDownloadAllSongsContextFunc.
+func (m *Client) PlanDownloadAllSongsContext(ctx context.Context, downloadDirectory string) (*goacnh.DownloadPlan, error) {
+	if m.PlanDownloadAllSongsContextFunc != nil {
+		return m.PlanDownloadAllSongsContextFunc(ctx, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// RecipeByName calls RecipeByNameFunc.
+func (m *Client) RecipeByName(name string) (*goacnh.Recipe, error) {
+	if m.RecipeByNameFunc != nil {
+		return m.RecipeByNameFunc(name)
+	}
+	return m.RecipeByNameContext(context.Background(), name)
+}
+
+// RecipeByNameContext calls RecipeByNameContextFunc.
+func (m *Client) RecipeByNameContext(ctx context.Context, name string) (*goacnh.Recipe, error) {
+	if m.RecipeByNameContextFunc != nil {
+		return m.RecipeByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// RecipeCraftedItem calls RecipeCraftedItemFunc.
+func (m *Client) RecipeCraftedItem(recipe *goacnh.Recipe) ([]*goacnh.ItemVariant, error) {
+	if m.RecipeCraftedItemFunc != nil {
+		return m.RecipeCraftedItemFunc(recipe)
+	}
+	return m.RecipeCraftedItemContext(context.Background(), recipe)
+}
+
+// RecipeCraftedItemContext calls RecipeCraftedItemContextFunc.
+func (m *Client) RecipeCraftedItemContext(ctx context.Context, recipe *goacnh.Recipe) ([]*goacnh.ItemVariant, error) {
+	if m.RecipeCraftedItemContextFunc != nil {
+		return m.RecipeCraftedItemContextFunc(ctx, recipe)
+	}
+	return nil, ErrNotMocked
+}
+
+// RecipeList calls RecipeListFunc.
+func (m *Client) RecipeList() ([]*goacnh.Recipe, error) {
+	if m.RecipeListFunc != nil {
+		return m.RecipeListFunc()
+	}
+	return m.RecipeListContext(context.Background())
+}
+
+// RecipeListContext calls RecipeListContextFunc.
+func (m *Client) RecipeListContext(ctx context.Context) ([]*goacnh.Recipe, error) {
+	if m.RecipeListContextFunc != nil {
+		return m.RecipeListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// RegisterCustomEntry calls RegisterCustomEntryFunc.
+func (m *Client) RegisterCustomEntry(entry *goacnh.CustomEntry) error {
+	if m.RegisterCustomEntryFunc != nil {
+		return m.RegisterCustomEntryFunc(entry)
+	}
+	return ErrNotMocked
+}
+
+// RemoveCustomEntry calls RemoveCustomEntryFunc.
+func (m *Client) RemoveCustomEntry(id int) {
+	if m.RemoveCustomEntryFunc != nil {
+		m.RemoveCustomEntryFunc(id)
+		return
+	}
+}
+
+// SeaCreatureByID calls SeaCreatureByIDFunc.
+func (m *Client) SeaCreatureByID(id int) (*goacnh.SeaCreature, error) {
+	if m.SeaCreatureByIDFunc != nil {
+		return m.SeaCreatureByIDFunc(id)
+	}
+	return m.SeaCreatureByIDContext(context.Background(), id)
+}
+
+// SeaCreatureByIDContext calls SeaCreatureByIDContextFunc.
+func (m *Client) SeaCreatureByIDContext(ctx context.Context, id int) (*goacnh.SeaCreature, error) {
+	if m.SeaCreatureByIDContextFunc != nil {
+		return m.SeaCreatureByIDContextFunc(ctx, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// SeaCreatureByName calls SeaCreatureByNameFunc.
+func (m *Client) SeaCreatureByName(name string) (*goacnh.SeaCreature, error) {
+	if m.SeaCreatureByNameFunc != nil {
+		return m.SeaCreatureByNameFunc(name)
+	}
+	return m.SeaCreatureByNameContext(context.Background(), name)
+}
+
+// SeaCreatureByNameContext calls SeaCreatureByNameContextFunc.
+func (m *Client) SeaCreatureByNameContext(ctx context.Context, name string) (*goacnh.SeaCreature, error) {
+	if m.SeaCreatureByNameContextFunc != nil {
+		return m.SeaCreatureByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// SeaCreatureIconDownload calls SeaCreatureIconDownloadFunc.
+func (m *Client) SeaCreatureIconDownload(id int, downloadDirectory string) (string, error) {
+	if m.SeaCreatureIconDownloadFunc != nil {
+		return m.SeaCreatureIconDownloadFunc(id, downloadDirectory)
+	}
+	return m.SeaCreatureIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// SeaCreatureIconDownloadContext calls SeaCreatureIconDownloadContextFunc.
+func (m *Client) SeaCreatureIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.SeaCreatureIconDownloadContextFunc != nil {
+		return m.SeaCreatureIconDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// SeaCreatureImageDownload calls SeaCreatureImageDownloadFunc.
+func (m *Client) SeaCreatureImageDownload(id int, downloadDirectory string) (string, error) {
+	if m.SeaCreatureImageDownloadFunc != nil {
+		return m.SeaCreatureImageDownloadFunc(id, downloadDirectory)
+	}
+	return m.SeaCreatureImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// SeaCreatureImageDownloadContext calls SeaCreatureImageDownloadContextFunc.
+func (m *Client) SeaCreatureImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.SeaCreatureImageDownloadContextFunc != nil {
+		return m.SeaCreatureImageDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// SeaCreatureList calls SeaCreatureListFunc.
+func (m *Client) SeaCreatureList() ([]*goacnh.SeaCreature, error) {
+	if m.SeaCreatureListFunc != nil {
+		return m.SeaCreatureListFunc()
+	}
+	return m.SeaCreatureListContext(context.Background())
+}
+
+// SeaCreatureListContext calls SeaCreatureListContextFunc.
+func (m *Client) SeaCreatureListContext(ctx context.Context) ([]*goacnh.SeaCreature, error) {
+	if m.SeaCreatureListContextFunc != nil {
+		return m.SeaCreatureListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// SeaCreaturesByIDs calls SeaCreaturesByIDsFunc.
+func (m *Client) SeaCreaturesByIDs(ids []int) ([]*goacnh.SeaCreature, error) {
+	if m.SeaCreaturesByIDsFunc != nil {
+		return m.SeaCreaturesByIDsFunc(ids)
+	}
+	return m.SeaCreaturesByIDsContext(context.Background(), ids)
+}
+
+// SeaCreaturesByIDsContext calls SeaCreaturesByIDsContextFunc.
+func (m *Client) SeaCreaturesByIDsContext(ctx context.Context, ids []int) ([]*goacnh.SeaCreature, error) {
+	if m.SeaCreaturesByIDsContextFunc != nil {
+		return m.SeaCreaturesByIDsContextFunc(ctx, ids)
+	}
+	return nil, ErrNotMocked
+}
+
+// SnapshotVersion calls SnapshotVersionFunc.
+func (m *Client) SnapshotVersion() (*goacnh.SnapshotVersion, error) {
+	if m.SnapshotVersionFunc != nil {
+		return m.SnapshotVersionFunc()
+	}
+	return nil, ErrNotMocked
+}
+
+// SongByExternalID calls SongByExternalIDFunc.
+func (m *Client) SongByExternalID(translator goacnh.IDTranslator, externalID string) (*goacnh.Song, error) {
+	if m.SongByExternalIDFunc != nil {
+		return m.SongByExternalIDFunc(translator, externalID)
+	}
+	return m.SongByExternalIDContext(context.Background(), translator, externalID)
+}
+
+// SongByExternalIDContext calls SongByExternalIDContextFunc.
+func (m *Client) SongByExternalIDContext(ctx context.Context, translator goacnh.IDTranslator, externalID string) (*goacnh.Song, error) {
+	if m.SongByExternalIDContextFunc != nil {
+		return m.SongByExternalIDContextFunc(ctx, translator, externalID)
+	}
+	return nil, ErrNotMocked
+}
+
+// SongByID calls SongByIDFunc.
+func (m *Client) SongByID(id int) (*goacnh.Song, error) {
+	if m.SongByIDFunc != nil {
+		return m.SongByIDFunc(id)
+	}
+	return m.SongByIDContext(context.Background(), id)
+}
+
+// SongByIDContext calls SongByIDContextFunc.
+func (m *Client) SongByIDContext(ctx context.Context, id int) (*goacnh.Song, error) {
+	if m.SongByIDContextFunc != nil {
+		return m.SongByIDContextFunc(ctx, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// SongByName calls SongByNameFunc.
+func (m *Client) SongByName(name string) (*goacnh.Song, error) {
+	if m.SongByNameFunc != nil {
+		return m.SongByNameFunc(name)
+	}
+	return m.SongByNameContext(context.Background(), name)
+}
+
+// SongByNameContext calls SongByNameContextFunc.
+func (m *Client) SongByNameContext(ctx context.Context, name string) (*goacnh.Song, error) {
+	if m.SongByNameContextFunc != nil {
+		return m.SongByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// SongDownload calls SongDownloadFunc.
+func (m *Client) SongDownload(song *goacnh.Song, downloadDirectory string) (string, error) {
+	if m.SongDownloadFunc != nil {
+		return m.SongDownloadFunc(song, downloadDirectory)
+	}
+	return m.SongDownloadContext(context.Background(), song, downloadDirectory)
+}
+
+// SongDownloadContext calls SongDownloadContextFunc.
+func (m *Client) SongDownloadContext(ctx context.Context, song *goacnh.Song, downloadDirectory string) (string, error) {
+	if m.SongDownloadContextFunc != nil {
+		return m.SongDownloadContextFunc(ctx, song, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// SongDownloadTemp calls SongDownloadTempFunc.
+func (m *Client) SongDownloadTemp(song *goacnh.Song) (string, error) {
+	if m.SongDownloadTempFunc != nil {
+		return m.SongDownloadTempFunc(song)
+	}
+	return m.SongDownloadTempContext(context.Background(), song)
+}
+
+// SongDownloadTempContext calls SongDownloadTempContextFunc.
+func (m *Client) SongDownloadTempContext(ctx context.Context, song *goacnh.Song) (string, error) {
+	if m.SongDownloadTempContextFunc != nil {
+		return m.SongDownloadTempContextFunc(ctx, song)
+	}
+	return "", ErrNotMocked
+}
+
+// SongDownloadTo calls SongDownloadToFunc.
+func (m *Client) SongDownloadTo(song *goacnh.Song, w io.Writer) error {
+	if m.SongDownloadToFunc != nil {
+		return m.SongDownloadToFunc(song, w)
+	}
+	return m.SongDownloadToContext(context.Background(), song, w)
+}
+
+// SongDownloadToContext calls SongDownloadToContextFunc.
+func (m *Client) SongDownloadToContext(ctx context.Context, song *goacnh.Song, w io.Writer) error {
+	if m.SongDownloadToContextFunc != nil {
+		return m.SongDownloadToContextFunc(ctx, song, w)
+	}
+	return ErrNotMocked
+}
+
+// SongList calls SongListFunc.
+func (m *Client) SongList() ([]*goacnh.Song, error) {
+	if m.SongListFunc != nil {
+		return m.SongListFunc()
+	}
+	return m.SongListContext(context.Background())
+}
+
+// SongListContext calls SongListContextFunc.
+func (m *Client) SongListContext(ctx context.Context) ([]*goacnh.Song, error) {
+	if m.SongListContextFunc != nil {
+		return m.SongListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// SongURL calls SongURLFunc.
+func (m *Client) SongURL(song *goacnh.Song) string {
+	if m.SongURLFunc != nil {
+		return m.SongURLFunc(song)
+	}
+	return ""
+}
+
+// SongsByIDs calls SongsByIDsFunc.
+func (m *Client) SongsByIDs(ids []int) ([]*goacnh.Song, error) {
+	if m.SongsByIDsFunc != nil {
+		return m.SongsByIDsFunc(ids)
+	}
+	return m.SongsByIDsContext(context.Background(), ids)
+}
+
+// SongsByIDsContext calls SongsByIDsContextFunc.
+func (m *Client) SongsByIDsContext(ctx context.Context, ids []int) ([]*goacnh.Song, error) {
+	if m.SongsByIDsContextFunc != nil {
+		return m.SongsByIDsContextFunc(ctx, ids)
+	}
+	return nil, ErrNotMocked
+}
+
+// TimeFormatter calls TimeFormatterFunc.
+func (m *Client) TimeFormatter(clock24 bool) goacnh.TimeFormatter {
+	if m.TimeFormatterFunc != nil {
+		return m.TimeFormatterFunc(clock24)
+	}
+	return goacnh.TimeFormatter{}
+}
+
+// Use calls UseFunc.
+func (m *Client) Use(middleware ...goacnh.Middleware) {
+	if m.UseFunc != nil {
+		m.UseFunc(middleware...)
+		return
+	}
+}
+
+// VariantByID calls VariantByIDFunc.
+func (m *Client) VariantByID(id int) (*goacnh.ItemVariant, error) {
+	if m.VariantByIDFunc != nil {
+		return m.VariantByIDFunc(id)
+	}
+	return m.VariantByIDContext(context.Background(), id)
+}
+
+// VariantByIDContext calls VariantByIDContextFunc.
+func (m *Client) VariantByIDContext(ctx context.Context, id int) (*goacnh.ItemVariant, error) {
+	if m.VariantByIDContextFunc != nil {
+		return m.VariantByIDContextFunc(ctx, id)
+	}
+	return nil, ErrNotMocked
+}
+
+// VariantImageDownload calls VariantImageDownloadFunc.
+func (m *Client) VariantImageDownload(variant *goacnh.ItemVariant, downloadDirectory string) (string, error) {
+	if m.VariantImageDownloadFunc != nil {
+		return m.VariantImageDownloadFunc(variant, downloadDirectory)
+	}
+	return m.VariantImageDownloadContext(context.Background(), variant, downloadDirectory)
+}
+
+// VariantImageDownloadContext calls VariantImageDownloadContextFunc.
+func (m *Client) VariantImageDownloadContext(ctx context.Context, variant *goacnh.ItemVariant, downloadDirectory string) (string, error) {
+	if m.VariantImageDownloadContextFunc != nil {
+		return m.VariantImageDownloadContextFunc(ctx, variant, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// VariantImageDownloadTo calls VariantImageDownloadToFunc.
+func (m *Client) VariantImageDownloadTo(variant *goacnh.ItemVariant, w io.Writer) error {
+	if m.VariantImageDownloadToFunc != nil {
+		return m.VariantImageDownloadToFunc(variant, w)
+	}
+	return m.VariantImageDownloadToContext(context.Background(), variant, w)
+}
+
+// VariantImageDownloadToContext calls VariantImageDownloadToContextFunc.
+func (m *Client) VariantImageDownloadToContext(ctx context.Context, variant *goacnh.ItemVariant, w io.Writer) error {
+	if m.VariantImageDownloadToContextFunc != nil {
+		return m.VariantImageDownloadToContextFunc(ctx, variant, w)
+	}
+	return ErrNotMocked
+}
+
+// VariantImagesDownload calls VariantImagesDownloadFunc.
+func (m *Client) VariantImagesDownload(itemName string, variants []*goacnh.ItemVariant, downloadDirectory string) ([]string, error) {
+	if m.VariantImagesDownloadFunc != nil {
+		return m.VariantImagesDownloadFunc(itemName, variants, downloadDirectory)
+	}
+	return m.VariantImagesDownloadContext(context.Background(), itemName, variants, downloadDirectory)
+}
+
+// VariantImagesDownloadContext calls VariantImagesDownloadContextFunc.
+func (m *Client) VariantImagesDownloadContext(ctx context.Context, itemName string, variants []*goacnh.ItemVariant, downloadDirectory string) ([]string, error) {
+	if m.VariantImagesDownloadContextFunc != nil {
+		return m.VariantImagesDownloadContextFunc(ctx, itemName, variants, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// VillagerIconDownload calls VillagerIconDownloadFunc.
+func (m *Client) VillagerIconDownload(id int, downloadDirectory string) (string, error) {
+	if m.VillagerIconDownloadFunc != nil {
+		return m.VillagerIconDownloadFunc(id, downloadDirectory)
+	}
+	return m.VillagerIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// VillagerIconDownloadContext calls VillagerIconDownloadContextFunc.
+func (m *Client) VillagerIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.VillagerIconDownloadContextFunc != nil {
+		return m.VillagerIconDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// VillagerIconDownloadTo calls VillagerIconDownloadToFunc.
+func (m *Client) VillagerIconDownloadTo(id int, w io.Writer) error {
+	if m.VillagerIconDownloadToFunc != nil {
+		return m.VillagerIconDownloadToFunc(id, w)
+	}
+	return m.VillagerIconDownloadToContext(context.Background(), id, w)
+}
+
+// VillagerIconDownloadToContext calls VillagerIconDownloadToContextFunc.
+func (m *Client) VillagerIconDownloadToContext(ctx context.Context, id int, w io.Writer) error {
+	if m.VillagerIconDownloadToContextFunc != nil {
+		return m.VillagerIconDownloadToContextFunc(ctx, id, w)
+	}
+	return ErrNotMocked
+}
+
+// VillagerImageDownload calls VillagerImageDownloadFunc.
+func (m *Client) VillagerImageDownload(id int, downloadDirectory string) (string, error) {
+	if m.VillagerImageDownloadFunc != nil {
+		return m.VillagerImageDownloadFunc(id, downloadDirectory)
+	}
+	return m.VillagerImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// VillagerImageDownloadContext calls VillagerImageDownloadContextFunc.
+func (m *Client) VillagerImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	if m.VillagerImageDownloadContextFunc != nil {
+		return m.VillagerImageDownloadContextFunc(ctx, id, downloadDirectory)
+	}
+	return "", ErrNotMocked
+}
+
+// VillagerImageDownloadTo calls VillagerImageDownloadToFunc.
+func (m *Client) VillagerImageDownloadTo(id int, w io.Writer) error {
+	if m.VillagerImageDownloadToFunc != nil {
+		return m.VillagerImageDownloadToFunc(id, w)
+	}
+	return m.VillagerImageDownloadToContext(context.Background(), id, w)
+}
+
+// VillagerImageDownloadToContext calls VillagerImageDownloadToContextFunc.
+func (m *Client) VillagerImageDownloadToContext(ctx context.Context, id int, w io.Writer) error {
+	if m.VillagerImageDownloadToContextFunc != nil {
+		return m.VillagerImageDownloadToContextFunc(ctx, id, w)
+	}
+	return ErrNotMocked
+}
+
+// WallMountedByName calls WallMountedByNameFunc.
+func (m *Client) WallMountedByName(name string) (*goacnh.WallMountedItem, error) {
+	if m.WallMountedByNameFunc != nil {
+		return m.WallMountedByNameFunc(name)
+	}
+	return m.WallMountedByNameContext(context.Background(), name)
+}
+
+// WallMountedByNameContext calls WallMountedByNameContextFunc.
+func (m *Client) WallMountedByNameContext(ctx context.Context, name string) (*goacnh.WallMountedItem, error) {
+	if m.WallMountedByNameContextFunc != nil {
+		return m.WallMountedByNameContextFunc(ctx, name)
+	}
+	return nil, ErrNotMocked
+}
+
+// WallMountedImagesDownload calls WallMountedImagesDownloadFunc.
+func (m *Client) WallMountedImagesDownload(item *goacnh.WallMountedItem, downloadDirectory string) ([]string, error) {
+	if m.WallMountedImagesDownloadFunc != nil {
+		return m.WallMountedImagesDownloadFunc(item, downloadDirectory)
+	}
+	return m.WallMountedImagesDownloadContext(context.Background(), item, downloadDirectory)
+}
+
+// WallMountedImagesDownloadContext calls WallMountedImagesDownloadContextFunc.
+func (m *Client) WallMountedImagesDownloadContext(ctx context.Context, item *goacnh.WallMountedItem, downloadDirectory string) ([]string, error) {
+	if m.WallMountedImagesDownloadContextFunc != nil {
+		return m.WallMountedImagesDownloadContextFunc(ctx, item, downloadDirectory)
+	}
+	return nil, ErrNotMocked
+}
+
+// WallMountedList calls WallMountedListFunc.
+func (m *Client) WallMountedList() ([]*goacnh.WallMountedItem, error) {
+	if m.WallMountedListFunc != nil {
+		return m.WallMountedListFunc()
+	}
+	return m.WallMountedListContext(context.Background())
+}
+
+// WallMountedListContext calls WallMountedListContextFunc.
+func (m *Client) WallMountedListContext(ctx context.Context) ([]*goacnh.WallMountedItem, error) {
+	if m.WallMountedListContextFunc != nil {
+		return m.WallMountedListContextFunc(ctx)
+	}
+	return nil, ErrNotMocked
+}
+
+// WriteSnapshot calls WriteSnapshotFunc.
+func (m *Client) WriteSnapshot(ctx context.Context, dir string) error {
+	if m.WriteSnapshotFunc != nil {
+		return m.WriteSnapshotFunc(ctx, dir)
+	}
+	return ErrNotMocked
+}
@@ -1,11 +1,10 @@
 package goacnh
 
 import (
-	"fmt"
+	"context"
+	"io"
 	"os"
-	"path"
 	"strconv"
-	"strings"
 )
 
 // Song represents a K.K.Slider song as represented via the API
@@ -15,96 +14,132 @@ type Song struct {
 	Name     map[string]string `json:"name"`
 }
 
+var songResource = &resource[Song]{
+	category:        SongCategory,
+	description:     "song",
+	listDescription: "song list",
+	list:            songListEndpoint,
+	stream:          streamList[Song],
+	item:            songEndpoint,
+	idParam:         "songID",
+	id:              func(song *Song) int { return song.ID },
+	names:           func(song *Song) []map[string]string { return []map[string]string{song.Name} },
+}
+
 const (
-	songNameLanguageCode string = "EUen"
-	songFileExtension    string = ".mp3"
+	songFileExtension string = ".mp3"
 )
 
 // SongList returns all the songs that the API provides. An error is returned if
-// the request failed or a non 200 error code was returned.
+// the request failed or a non 200 error code was returned. Use SongListContext
+// to control cancellation and deadlines.
 func (c *Client) SongList() ([]*Song, error) {
-	var songMap map[string]*Song
-	resp, err := c.restClient.R().
-		SetHeader("Accept", "application/json").
-		SetPathParam("apiVersion", strconv.Itoa(1)).
-		SetResult(&songMap).
-		Get("/v{apiVersion}/songs")
-	if err != nil {
-		return nil, fmt.Errorf("failed to request song list: %w", err)
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("received non-200 status code (%d)", resp.StatusCode())
-	}
-	songList := make([]*Song, 0)
-	for _, value := range songMap {
-		songList = append(songList, value)
-	}
-	return songList, nil
+	return c.SongListContext(context.Background())
+}
+
+// SongListContext is like SongList but makes its requests with the given
+// context.
+func (c *Client) SongListContext(ctx context.Context) ([]*Song, error) {
+	return songResource.listAll(ctx, c)
 }
 
 // SongByID gets a single song based on the ID provided. An error is returned if
-// the request failed or a non 200 error code was returned.
+// the request failed or a non 200 error code was returned. Use SongByIDContext
+// to control cancellation and deadlines.
 func (c *Client) SongByID(id int) (*Song, error) {
-	var song *Song
-	resp, err := c.restClient.R().
-		SetHeader("Accept", "application/json").
-		SetPathParam("apiVersion", strconv.Itoa(1)).
-		SetPathParam("songID", strconv.Itoa(id)).
-		SetResult(&song).
-		Get("/v{apiVersion}/songs/{songID}")
-	if err != nil {
-		return nil, fmt.Errorf("failed to request song: %w", err)
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("received non-200 status code (%d)", resp.StatusCode())
-	}
-	return song, nil
+	return c.SongByIDContext(context.Background(), id)
+}
+
+// SongByIDContext is like SongByID but makes its requests with the given
+// context.
+func (c *Client) SongByIDContext(ctx context.Context, id int) (*Song, error) {
+	return songResource.byID(ctx, c, id)
+}
+
+// SongsByIDs gets the songs with each of the given IDs, making several requests
+// at once, up to the limit set by WithConcurrency. They are returned in the
+// order of their IDs. If any could not be got, the rest are still returned,
+// with nil in place of those that failed, along with a *BatchError holding the
+// error of each. Use SongsByIDsContext to control cancellation and deadlines.
+func (c *Client) SongsByIDs(ids []int) ([]*Song, error) {
+	return c.SongsByIDsContext(context.Background(), ids)
+}
+
+// SongsByIDsContext is like SongsByIDs but makes its requests with the given
+// context.
+func (c *Client) SongsByIDsContext(ctx context.Context, ids []int) ([]*Song, error) {
+	return songResource.byIDs(ctx, c, ids)
 }
 
-// SongByName get a song based on its name. It is important to note that
-// language of the name is set to EUen. An error is returned if the request
-// failed or a non 200 error code was returned or no match was found.
+// SongByName get a song based on its name. The name is compared with its names
+// in each of the client's languages, and then in any other language. An error
+// is returned if the request failed or a non 200 error code was returned or no
+// match was found. Use SongByNameContext to control cancellation and deadlines.
 func (c *Client) SongByName(name string) (*Song, error) {
-	songList, err := c.SongList()
-	if err != nil {
-		return nil, err
-	}
-	name = strings.ToLower(name)
-	for _, song := range songList {
-		if strings.ToLower(song.Name[fmt.Sprintf("name-%s", songNameLanguageCode)]) == name {
-			return song, nil
-		}
-	}
-	return nil, fmt.Errorf("failed to find a match")
+	return c.SongByNameContext(context.Background(), name)
+}
+
+// SongByNameContext is like SongByName but makes its requests with the given
+// context.
+func (c *Client) SongByNameContext(ctx context.Context, name string) (*Song, error) {
+	return songResource.byName(ctx, c, name)
 }
 
 // SongDownload downloads the given track as an MP3 file to a given directory.
 // The file name of the download is that specified as the file name by the API.
 // The given download dir must exist before calling this. Returned is the file
-// path of the download song, provided there was no error.
+// path of the download song, provided there was no error. In dry-run mode the
+// path is returned without anything being downloaded. Use SongDownloadContext
+// to control cancellation and deadlines.
 func (c *Client) SongDownload(song *Song, downloadDirectory string) (string, error) {
-	if !dirExists(downloadDirectory) {
-		return "", fmt.Errorf("destination download directory does not exist")
-	}
-	outputFilePath := path.Join(downloadDirectory, song.FileName) + songFileExtension
-	resp, err := c.restClient.R().
-		SetHeader("Accept", "application/json").
-		SetPathParam("apiVersion", strconv.Itoa(1)).
-		SetPathParam("songID", strconv.Itoa(song.ID)).
-		SetOutput(outputFilePath).
-		Get("/v{apiVersion}/music/{songID}")
-	if err != nil {
-		return "", fmt.Errorf("failed to download background music track: %w", err)
-	}
-	if resp.StatusCode() != 200 {
-		return "", fmt.Errorf("received non-200 status code (%d)", resp.StatusCode())
+	return c.SongDownloadContext(context.Background(), song, downloadDirectory)
+}
+
+// SongDownloadContext is like SongDownload but makes its requests with the
+// given context.
+func (c *Client) SongDownloadContext(ctx context.Context, song *Song, downloadDirectory string) (string, error) {
+	return c.download(ctx, songDownloadRequest(song), downloadDirectory)
+}
+
+// songDownloadRequest describes the MP3 file of a song.
+func songDownloadRequest(song *Song) downloadRequest {
+	return downloadRequest{
+		endpoint: songFileEndpoint,
+		pathParams: map[string]string{
+			"songID": strconv.Itoa(song.ID),
+		},
+		fileName:    song.FileName + songFileExtension,
+		description: "song",
+		audio:       true,
+		coverArt:    songCoverArt(song),
+		entity:      song,
 	}
-	return outputFilePath, nil
+}
+
+// SongDownloadTo writes the MP3 file of the given song to w, such as an HTTP
+// response, without touching the local filesystem. An error is returned if the
+// request failed or a non 200 error code was returned. Use
+// SongDownloadToContext to control cancellation and deadlines.
+func (c *Client) SongDownloadTo(song *Song, w io.Writer) error {
+	return c.SongDownloadToContext(context.Background(), song, w)
+}
+
+// SongDownloadToContext is like SongDownloadTo but makes its requests with the
+// given context.
+func (c *Client) SongDownloadToContext(ctx context.Context, song *Song, w io.Writer) error {
+	return c.downloadTo(ctx, songDownloadRequest(song), w)
 }
 
 // SongDownload downloads the given track as an MP3 file to a temp directory. Th
 // file name of the download is that specified as the file name by the API.
 // Returned is the file path of the download song, provided there was no error.
+// Use SongDownloadTempContext to control cancellation and deadlines.
 func (c *Client) SongDownloadTemp(song *Song) (string, error) {
-	return c.SongDownload(song, os.TempDir())
+	return c.SongDownloadTempContext(context.Background(), song)
+}
+
+// SongDownloadTempContext is like SongDownloadTemp but makes its requests with
+// the given context.
+func (c *Client) SongDownloadTempContext(ctx context.Context, song *Song) (string, error) {
+	return c.SongDownloadContext(ctx, song, os.TempDir())
 }
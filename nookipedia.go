@@ -0,0 +1,337 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// defaultNookipediaBaseURL is the URL of the Nookipedia API, which succeeds
+// acnhapi.com.
+const defaultNookipediaBaseURL string = "https://api.nookipedia.com"
+
+// nookipediaVersion is the version of the Nookipedia API whose schemas are
+// mapped, sent in the Accept-Version header.
+const nookipediaVersion string = "1.0.0"
+
+// nookipediaPaths are the Nookipedia endpoints that categories are read from.
+var nookipediaPaths = map[Category]string{
+	FishCategory:        "/nh/fish",
+	SeaCreatureCategory: "/nh/sea",
+	FossilCategory:      "/nh/fossils/individuals",
+	ArtCategory:         "/nh/art",
+}
+
+// WithNookipedia makes the client read its lists from the Nookipedia API,
+// authenticating with the given API key, instead of acnhapi.com, which is no
+// longer maintained. Nookipedia entries are mapped onto the types of this
+// package: fish, sea creatures, fossils and art are provided, and other
+// categories have no data. Songs are not covered, as Nookipedia does not serve
+// K.K. songs, and neither are villagers, as this package only downloads their
+// images. Names are only given in English, and art, which Nookipedia does not
+// number, is given IDs in the order it is listed. Images and audio files
+// downloaded by ID are still requested from the base URL.
+func WithNookipedia(apiKey string) Option {
+	return func(c *Client) {
+		c.source = NookipediaSource(apiKey, c)
+		c.sourceOptions = append(c.sourceOptions, "WithNookipedia")
+		c.nookipediaKeyMissing = apiKey == ""
+	}
+}
+
+// WithNookipediaBaseURL sets the URL the Nookipedia API is served from for
+// WithNookipedia and NookipediaSource, which defaults to
+// https://api.nookipedia.com. This allows a mirror of the API, or a server
+// that replays recorded responses, to be used.
+func WithNookipediaBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.nookipediaURL = baseURL
+	}
+}
+
+// NookipediaSource returns a Source that reads categories from the Nookipedia
+// API, as WithNookipedia does, so that it can be combined with other sources.
+// Its requests are sent through the given client, with its retries, rate limit
+// and middleware.
+func NookipediaSource(apiKey string, client *Client) Source {
+	return &nookipediaSource{apiKey: apiKey, client: client}
+}
+
+// nookipediaSource is a Source that requests categories from the Nookipedia
+// API and converts them to the shape acnhapi.com served them in.
+type nookipediaSource struct {
+	apiKey string
+	client *Client
+}
+
+func (s *nookipediaSource) CategoryJSON(ctx context.Context, category Category) ([]byte, error) {
+	path, ok := nookipediaPaths[category]
+	if !ok {
+		return nil, fmt.Errorf("no %s data: %w", category, ErrNotFound)
+	}
+	resp, err := s.client.restClient.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetHeader("Accept-Version", nookipediaVersion).
+		SetHeader("X-API-KEY", s.apiKey).
+		SetDoNotParseResponse(true).
+		Get(strings.TrimSuffix(s.client.nookipediaURL, "/") + path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s from nookipedia: %w", category, err)
+	}
+	defer resp.RawBody().Close()
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(responseBody(resp))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from nookipedia: %w", category, err)
+	}
+	var entries map[string]interface{}
+	switch category {
+	case FishCategory:
+		entries, err = convertNookipedia(data, nookipediaCreature.fish)
+	case SeaCreatureCategory:
+		entries, err = convertNookipedia(data, nookipediaCreature.seaCreature)
+	case FossilCategory:
+		entries, err = convertNookipedia(data, nookipediaFossil.fossil)
+	case ArtCategory:
+		entries, err = convertNookipedia(data, nookipediaArt.art)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s from nookipedia: %w", category, err)
+	}
+	return json.Marshal(entries)
+}
+
+// convertNookipedia decodes a Nookipedia list and converts each entry, given
+// its position in the list, keyed by the file name it is given.
+func convertNookipedia[N any](data []byte, convert func(N, int) (string, interface{})) (map[string]interface{}, error) {
+	var list []N
+	if err := jsonUnmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	entries := make(map[string]interface{}, len(list))
+	for i, entry := range list {
+		fileName, converted := convert(entry, i)
+		entries[fileName] = converted
+	}
+	return entries, nil
+}
+
+type nookipediaHemisphere struct {
+	MonthsArray       []int `json:"months_array"`
+	AvailabilityArray []struct {
+		Months string `json:"months"`
+		Time   string `json:"time"`
+	} `json:"availability_array"`
+}
+
+type nookipediaCreature struct {
+	Name           string               `json:"name"`
+	Number         int                  `json:"number"`
+	ImageURL       string               `json:"image_url"`
+	RenderURL      string               `json:"render_url"`
+	Catchphrases   []string             `json:"catchphrases"`
+	Location       string               `json:"location"`
+	ShadowSize     string               `json:"shadow_size"`
+	ShadowMovement string               `json:"shadow_movement"`
+	Rarity         string               `json:"rarity"`
+	SellNook       int                  `json:"sell_nook"`
+	SellCJ         int                  `json:"sell_cj"`
+	North          nookipediaHemisphere `json:"north"`
+	South          nookipediaHemisphere `json:"south"`
+}
+
+func (n nookipediaCreature) fish(_ int) (string, interface{}) {
+	fileName := nookipediaFileName(n.Name)
+	return fileName, &Fish{
+		ID:           n.Number,
+		FileName:     fileName,
+		Name:         nookipediaNames(n.Name),
+		Availability: n.availability(),
+		Shadow:       n.ShadowSize,
+		Price:        n.SellNook,
+		PriceCJ:      n.SellCJ,
+		CatchPhrase:  firstOf(n.Catchphrases),
+		ImageURI:     n.RenderURL,
+		IconURI:      n.ImageURL,
+	}
+}
+
+func (n nookipediaCreature) seaCreature(_ int) (string, interface{}) {
+	fileName := nookipediaFileName(n.Name)
+	return fileName, &SeaCreature{
+		ID:           n.Number,
+		FileName:     fileName,
+		Name:         nookipediaNames(n.Name),
+		Availability: n.availability(),
+		Speed:        n.ShadowMovement,
+		Shadow:       n.ShadowSize,
+		Price:        n.SellNook,
+		CatchPhrase:  firstOf(n.Catchphrases),
+		ImageURI:     n.RenderURL,
+		IconURI:      n.ImageURL,
+	}
+}
+
+// availability converts the availability of a creature, taking the hours it
+// can be caught at from the first availability given for the northern
+// hemisphere.
+func (n nookipediaCreature) availability() Availability {
+	a := Availability{
+		MonthNorthern:      monthRanges(n.North.MonthsArray),
+		MonthSouthern:      monthRanges(n.South.MonthsArray),
+		IsAllYear:          len(n.North.MonthsArray) == 12,
+		Location:           n.Location,
+		Rarity:             n.Rarity,
+		MonthArrayNorthern: n.North.MonthsArray,
+		MonthArraySouthern: n.South.MonthsArray,
+	}
+	var hours string
+	if len(n.North.AvailabilityArray) > 0 {
+		hours = n.North.AvailabilityArray[0].Time
+	}
+	a.TimeArray, a.Time = parseNookipediaHours(hours)
+	a.IsAllDay = len(a.TimeArray) == 24
+	if a.IsAllDay {
+		a.Time = ""
+	}
+	return a
+}
+
+type nookipediaFossil struct {
+	Name        string `json:"name"`
+	ImageURL    string `json:"image_url"`
+	FossilGroup string `json:"fossil_group"`
+	Sell        int    `json:"sell"`
+}
+
+func (n nookipediaFossil) fossil(_ int) (string, interface{}) {
+	fileName := nookipediaFileName(n.Name)
+	return fileName, &Fossil{
+		FileName: fileName,
+		Name:     nookipediaNames(n.Name),
+		Price:    n.Sell,
+		ImageURI: n.ImageURL,
+		PartOf:   nookipediaFileName(n.FossilGroup),
+	}
+}
+
+type nookipediaArt struct {
+	Name     string `json:"name"`
+	HasFake  bool   `json:"has_fake"`
+	Buy      int    `json:"buy"`
+	Sell     int    `json:"sell"`
+	RealInfo struct {
+		ImageURL    string `json:"image_url"`
+		Description string `json:"description"`
+	} `json:"real_info"`
+}
+
+func (n nookipediaArt) art(index int) (string, interface{}) {
+	fileName := nookipediaFileName(n.Name)
+	return fileName, &Art{
+		ID:         index + 1,
+		FileName:   fileName,
+		Name:       nookipediaNames(n.Name),
+		HasFake:    n.HasFake,
+		BuyPrice:   n.Buy,
+		SellPrice:  n.Sell,
+		ImageURI:   n.RealInfo.ImageURL,
+		MuseumDesc: n.RealInfo.Description,
+	}
+}
+
+// nookipediaFileNameSeparators matches the runs of characters that are
+// replaced by an underscore in file names.
+var nookipediaFileNameSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// nookipediaFileName derives a file name in the style of acnhapi.com, such as
+// sea_bass, from an entry's name.
+func nookipediaFileName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "'", "")
+	return strings.Trim(nookipediaFileNameSeparators.ReplaceAllString(name, "_"), "_")
+}
+
+func nookipediaNames(name string) map[string]string {
+	return map[string]string{"name-" + defaultLanguageCode: name}
+}
+
+func firstOf(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// monthRanges renders months (1-12) as ranges in the style of acnhapi.com,
+// such as "11-3" or "4-6 & 9-11", or as an empty string for all year.
+func monthRanges(months []int) string {
+	zeroBased := make([]int, 0, len(months))
+	for _, month := range months {
+		zeroBased = append(zeroBased, month-1)
+	}
+	runs := cyclicRuns(zeroBased, 12)
+	ranges := make([]string, 0, len(runs))
+	for _, run := range runs {
+		if run[0] == run[1] {
+			ranges = append(ranges, strconv.Itoa(run[0]+1))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", run[0]+1, run[1]+1))
+		}
+	}
+	return strings.Join(ranges, " & ")
+}
+
+// nookipediaHourRange matches a window of hours as Nookipedia writes them,
+// such as "4 AM – 9 PM".
+var nookipediaHourRange = regexp.MustCompile(`(\d{1,2})\s*([AP]M)\s*[–-]\s*(\d{1,2})\s*([AP]M)`)
+
+// parseNookipediaHours converts the hours a creature can be caught at, as
+// Nookipedia writes them, to the hours of the day (0-23) they cover and their
+// description in the style of acnhapi.com, such as "4am - 9pm". Anything that
+// is not a window of hours, such as "All day", covers every hour.
+func parseNookipediaHours(hours string) ([]int, string) {
+	windows := nookipediaHourRange.FindAllStringSubmatch(hours, -1)
+	covered := make([]bool, 24)
+	descriptions := make([]string, 0, len(windows))
+	for _, window := range windows {
+		start, end := hourOfDay(window[1], window[2]), hourOfDay(window[3], window[4])
+		if start < 0 || end < 0 {
+			continue
+		}
+		for hour := start; ; hour = (hour + 1) % 24 {
+			covered[hour] = true
+			if (hour+1)%24 == end {
+				break
+			}
+		}
+		descriptions = append(descriptions, strings.ToLower(window[1]+window[2]+" - "+window[3]+window[4]))
+	}
+	timeArray := make([]int, 0, 24)
+	for hour, ok := range covered {
+		if ok || len(descriptions) == 0 {
+			timeArray = append(timeArray, hour)
+		}
+	}
+	return timeArray, strings.Join(descriptions, " & ")
+}
+
+// hourOfDay converts an hour of a 12-hour clock to an hour of the day (0-23),
+// or -1 if it is out of range.
+func hourOfDay(hour string, meridiem string) int {
+	h, err := strconv.Atoi(hour)
+	if err != nil || h < 1 || h > 12 {
+		return -1
+	}
+	h %= 12
+	if meridiem == "PM" {
+		h += 12
+	}
+	return h
+}
@@ -0,0 +1,75 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrOffline is returned for any request made by a client created with
+// WithOfflineData.
+var ErrOffline = errors.New("client is offline")
+
+// WithOfflineData makes the client read every list, and every entity looked up
+// by ID, from a snapshot of the API supplied by the caller in fsys, and never
+// use the network. No snapshot is bundled with this package, so one has to be
+// written first, such as with WriteSnapshot, and then read from disk or
+// embedded in the program with go:embed. Any request the client would
+// otherwise make, such as to download an image or audio file, fails with
+// ErrOffline.
+func WithOfflineData(fsys fs.FS) Option {
+	return func(c *Client) {
+		c.source = FSSource(fsys)
+		c.offlineData = fsys
+		c.sourceOptions = append(c.sourceOptions, "WithOfflineData")
+	}
+}
+
+// offlineTransport is a round tripper that refuses every request.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	return nil, ErrOffline
+}
+
+// WriteSnapshot fetches every category that can be listed from the API and
+// writes its raw JSON to a file in dir named after it, such as fish.json, in
+// the layout read by FSSource and WithOfflineData, along with a snapshot.json
+// file recording its content hash and when it was written, as reported by
+// SnapshotVersion. The directory is created if it does not exist.
+func (c *Client) WriteSnapshot(ctx context.Context, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	contents := make(map[Category][]byte)
+	for _, category := range listCategories() {
+		data, err := c.CategoryJSON(ctx, category)
+		if err != nil {
+			return fmt.Errorf("failed to snapshot %s: %w", category, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, string(category)+".json"), data, 0o644); err != nil {
+			return fmt.Errorf("failed to snapshot %s: %w", category, err)
+		}
+		contents[category] = data
+	}
+	manifest, err := json.MarshalIndent(snapshotManifest{
+		Hash:    snapshotHash(contents),
+		Created: time.Now().UTC().Truncate(time.Second),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, snapshotManifestName), append(manifest, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot manifest: %w", err)
+	}
+	return nil
+}
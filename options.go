@@ -0,0 +1,175 @@
+package goacnh
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/time/rate"
+)
+
+// Option configures a Client when passed to New.
+type Option func(*Client)
+
+// WithHTTPClient makes the client send its requests with the given HTTP
+// client, such as one with a custom transport. Other options that affect the
+// HTTP client, like WithTransport, are applied to a copy of it, so the same
+// HTTP client can be given to several clients.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRestyClient makes the client send its requests with the given resty
+// client, for callers that already have one set up. Any other options given
+// are applied to it, and take precedence over WithHTTPClient. The resty client
+// is reconfigured by New, which sets its base URL and adds its own hooks and
+// transports, so it must not be shared with other clients or given to New
+// more than once.
+func WithRestyClient(restClient *resty.Client) Option {
+	return func(c *Client) {
+		c.restClient = restClient
+	}
+}
+
+// WithTransport makes the client send its requests through the given round
+// tripper. This allows proxies, custom TLS set ups, instrumentation, or test
+// doubles to be used without replacing the whole HTTP client.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithProxy makes the client send its requests through the HTTP or HTTPS proxy
+// at the given URL, such as http://proxy.example.com:3128. By default the
+// proxy set by the HTTP_PROXY and HTTPS_PROXY environment variables is used.
+// It applies to the transport the client creates, and to one given by
+// WithTransport or WithHTTPClient only if it is an *http.Transport, which is
+// copied rather than changed, so that a shared transport such as
+// http.DefaultTransport is left as it is. Other transports are used as they
+// are, which is logged.
+func WithProxy(proxyURL string) Option {
+	return func(c *Client) {
+		c.proxyURL = proxyURL
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to the API, such as
+// to present a client certificate or require a minimum TLS version. Like
+// WithProxy, it only applies to an *http.Transport.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// WithRootCAs sets the certificate authorities trusted when connecting to the
+// API, in place of those of the system, for environments where traffic is
+// re-signed by a private CA. It takes precedence over the RootCAs of
+// WithTLSConfig and, like it, only applies to an *http.Transport.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(c *Client) {
+		c.rootCAs = pool
+	}
+}
+
+// WithBaseURL sets the URL the API is served from, which defaults to
+// https://acnhapi.com. This allows a mirror of the API to be used.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithTimeout limits how long each API request, including any retries and
+// reading its response, may take. Downloads are limited separately by
+// WithDownloadTimeout. By default requests have no time limit. A shorter limit
+// for a single call can be set through the context given to its Context form.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithDownloadTimeout limits how long each image or audio download, including
+// any retries, may take. By default downloads have no time limit.
+func WithDownloadTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.downloadTimeout = timeout
+	}
+}
+
+// WithRetry makes requests and downloads that fail with a transient error be
+// retried up to count times. Transient network errors and responses with a 429
+// or 5xx status code are retried, as described by IsRetryable. The wait
+// between attempts starts at baseDelay and backs off exponentially, with
+// jitter, up to maxDelay.
+func WithRetry(count int, baseDelay time.Duration, maxDelay time.Duration) Option {
+	return func(c *Client) {
+		c.retryCount = count
+		c.retryWaitTime = baseDelay
+		c.retryMaxWaitTime = maxDelay
+	}
+}
+
+// WithRateLimit limits the client to making at most rps requests per second,
+// including downloads and retried attempts. Requests over the limit wait for
+// their turn, or until their context is done. This keeps batch jobs, such as
+// downloading every song, from being blocked by the API. A rate that is not
+// positive disables the limit, which is the default.
+func WithRateLimit(rps float64) Option {
+	return func(c *Client) {
+		if rps <= 0 {
+			c.rateLimiter = nil
+			return
+		}
+		c.rateLimiter = rate.NewLimiter(rate.Limit(rps), 1)
+	}
+}
+
+// WithConcurrency limits batch lookups and downloads, such as SongsByIDs and
+// DownloadAllSongs, to making n requests at once. The default is 4, and a
+// limit that is not positive is treated as 1.
+func WithConcurrency(n int) Option {
+	return func(c *Client) {
+		c.concurrency = n
+	}
+}
+
+// WithResume makes downloads to a directory resumable. Each file is written
+// under its name with a .part suffix until it is complete, and if a download
+// is interrupted, the next download of the same file continues from where it
+// stopped with an HTTP Range request. The downloaded size is checked against
+// the size the server reports before the file is given its name. Servers that
+// do not support ranges have the file downloaded from the start.
+func WithResume(resume bool) Option {
+	return func(c *Client) {
+		c.resume = resume
+	}
+}
+
+// WithSkipExisting makes downloads to a directory return straight away if the
+// file is already there with the size the server reports for it, which is
+// checked with a HEAD request, so that batch jobs such as DownloadAllSongs can
+// be run again cheaply to fetch only what is missing. Files that are not yet
+// complete are downloaded again, following the overwrite policy.
+func WithSkipExisting() Option {
+	return func(c *Client) {
+		c.skipExisting = true
+	}
+}
+
+// WithDryRun makes download methods validate their arguments and report the
+// file path they would write to, without downloading anything or touching the
+// disk. Batch downloads such as DownloadAllSongs still request the list of
+// what they would download, but nothing more. This is useful for previewing
+// large download jobs, as are PlanDownloadAllSongs and PlanDownloadAllBGM.
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
@@ -0,0 +1,70 @@
+package goacnh
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Overrides holds local corrections to the data of each category, as JSON
+// Merge Patches (RFC 7386) keyed by category and then by the file name that
+// identifies each entry, such as {"fish": {"bitterling": {"price": 1000}}}. A
+// null value removes a field, or a whole entry.
+type Overrides map[Category]map[string]json.RawMessage
+
+// LoadOverrides reads Overrides from JSON, such as an overrides.json file.
+func LoadOverrides(r io.Reader) (Overrides, error) {
+	o := make(Overrides)
+	if err := json.NewDecoder(r).Decode(&o); err != nil {
+		return nil, fmt.Errorf("failed to decode overrides: %w", err)
+	}
+	return o, nil
+}
+
+// WithOverrides makes the client apply the given overrides to every list it
+// fetches or reads from its Source, so that misnamed or mispriced entries can
+// be fixed locally and stay fixed when the data is refreshed. Entities looked
+// up by ID are found in the overridden lists.
+func WithOverrides(overrides Overrides) Option {
+	return func(c *Client) {
+		c.overrides = overrides
+	}
+}
+
+// apply returns the data of a category with its overrides applied.
+func (o Overrides) apply(category Category, data []byte) ([]byte, error) {
+	patch, ok := o[category]
+	if !ok || len(patch) == 0 {
+		return data, nil
+	}
+	encoded, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	return mergePatch(data, encoded)
+}
+
+// mergePatch applies a JSON Merge Patch to a JSON document.
+func mergePatch(target []byte, patch []byte) ([]byte, error) {
+	var patchFields map[string]json.RawMessage
+	if jsonUnmarshal(patch, &patchFields) != nil || patchFields == nil {
+		return patch, nil
+	}
+	var targetFields map[string]json.RawMessage
+	if jsonUnmarshal(target, &targetFields) != nil || targetFields == nil {
+		targetFields = make(map[string]json.RawMessage)
+	}
+	for key, value := range patchFields {
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			delete(targetFields, key)
+			continue
+		}
+		merged, err := mergePatch(targetFields[key], value)
+		if err != nil {
+			return nil, err
+		}
+		targetFields[key] = merged
+	}
+	return json.Marshal(targetFields)
+}
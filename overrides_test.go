@@ -0,0 +1,124 @@
+package goacnh
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOverridesApply(t *testing.T) {
+	data := `{"bitterling":{"id":1,"price":900,"name":{"name-EUen":"bitterling","name-JPja":"タナゴ"}},"pale_chub":{"id":2,"price":200}}`
+	tests := []struct {
+		name      string
+		overrides Overrides
+		want      string
+	}{
+		{name: "no overrides", want: data},
+		{
+			name:      "other category",
+			overrides: Overrides{SeaCreatureCategory: {"bitterling": json.RawMessage(`{"price":1}`)}},
+			want:      data,
+		},
+		{
+			name:      "replace field",
+			overrides: Overrides{FishCategory: {"bitterling": json.RawMessage(`{"price":1000}`)}},
+			want:      `{"bitterling":{"id":1,"price":1000,"name":{"name-EUen":"bitterling","name-JPja":"タナゴ"}},"pale_chub":{"id":2,"price":200}}`,
+		},
+		{
+			name:      "add field",
+			overrides: Overrides{FishCategory: {"pale_chub": json.RawMessage(`{"shadow":"Smallest (1)"}`)}},
+			want:      `{"bitterling":{"id":1,"price":900,"name":{"name-EUen":"bitterling","name-JPja":"タナゴ"}},"pale_chub":{"id":2,"price":200,"shadow":"Smallest (1)"}}`,
+		},
+		{
+			name:      "merge nested object",
+			overrides: Overrides{FishCategory: {"bitterling": json.RawMessage(`{"name":{"name-EUen":"Bitterling"}}`)}},
+			want:      `{"bitterling":{"id":1,"price":900,"name":{"name-EUen":"Bitterling","name-JPja":"タナゴ"}},"pale_chub":{"id":2,"price":200}}`,
+		},
+		{
+			name:      "remove field",
+			overrides: Overrides{FishCategory: {"bitterling": json.RawMessage(`{"name":{"name-JPja":null},"price":null}`)}},
+			want:      `{"bitterling":{"id":1,"name":{"name-EUen":"bitterling"}},"pale_chub":{"id":2,"price":200}}`,
+		},
+		{
+			name:      "remove entry",
+			overrides: Overrides{FishCategory: {"pale_chub": json.RawMessage(`null`)}},
+			want:      `{"bitterling":{"id":1,"price":900,"name":{"name-EUen":"bitterling","name-JPja":"タナゴ"}}}`,
+		},
+		{
+			name:      "add entry",
+			overrides: Overrides{FishCategory: {"dace": json.RawMessage(`{"id":3,"price":240}`)}},
+			want:      `{"bitterling":{"id":1,"price":900,"name":{"name-EUen":"bitterling","name-JPja":"タナゴ"}},"pale_chub":{"id":2,"price":200},"dace":{"id":3,"price":240}}`,
+		},
+		{
+			name:      "replace object with value",
+			overrides: Overrides{FishCategory: {"bitterling": json.RawMessage(`{"name":"bitterling"}`)}},
+			want:      `{"bitterling":{"id":1,"price":900,"name":"bitterling"},"pale_chub":{"id":2,"price":200}}`,
+		},
+		{
+			name:      "replace array",
+			overrides: Overrides{FishCategory: {"pale_chub": json.RawMessage(`{"months":[1,2]}`), "bitterling": json.RawMessage(`{"id":1}`)}},
+			want:      `{"bitterling":{"id":1,"price":900,"name":{"name-EUen":"bitterling","name-JPja":"タナゴ"}},"pale_chub":{"id":2,"price":200,"months":[1,2]}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.overrides.apply(FishCategory, []byte(data))
+			if err != nil {
+				t.Fatalf("apply() failed: %v", err)
+			}
+			var gotValue, wantValue interface{}
+			if err := json.Unmarshal(got, &gotValue); err != nil {
+				t.Fatalf("apply() returned invalid JSON %s: %v", got, err)
+			}
+			if err := json.Unmarshal([]byte(test.want), &wantValue); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Errorf("apply() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	// Cases from the examples of RFC 7386.
+	tests := []struct {
+		target string
+		patch  string
+		want   string
+	}{
+		{target: `{"a":"b"}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"b":"c"}`, want: `{"a":"b","b":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"a":null}`, want: `{}`},
+		{target: `{"a":"b","b":"c"}`, patch: `{"a":null}`, want: `{"b":"c"}`},
+		{target: `{"a":["b"]}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{target: `{"a":"c"}`, patch: `{"a":["b"]}`, want: `{"a":["b"]}`},
+		{target: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, want: `{"a":{"b":"d"}}`},
+		{target: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, want: `{"a":[1]}`},
+		{target: `["a","b"]`, patch: `["c","d"]`, want: `["c","d"]`},
+		{target: `{"a":"b"}`, patch: `["c"]`, want: `["c"]`},
+		{target: `{"a":"foo"}`, patch: `null`, want: `null`},
+		{target: `{"a":"foo"}`, patch: `"bar"`, want: `"bar"`},
+		{target: `{"e":null}`, patch: `{"a":1}`, want: `{"e":null,"a":1}`},
+		{target: `[1,2]`, patch: `{"a":"b","c":null}`, want: `{"a":"b"}`},
+		{target: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, want: `{"a":{"bb":{}}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.target+" "+test.patch, func(t *testing.T) {
+			got, err := mergePatch([]byte(test.target), []byte(test.patch))
+			if err != nil {
+				t.Fatalf("mergePatch(%s, %s) failed: %v", test.target, test.patch, err)
+			}
+			var gotValue, wantValue interface{}
+			if err := json.Unmarshal(got, &gotValue); err != nil {
+				t.Fatalf("mergePatch(%s, %s) returned invalid JSON %s: %v", test.target, test.patch, got, err)
+			}
+			if err := json.Unmarshal([]byte(test.want), &wantValue); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Errorf("mergePatch(%s, %s) = %s, want %s", test.target, test.patch, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package goacnh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// GetRaw returns the JSON of a single entry of a category exactly as the API,
+// or the client's Source, provides it, without any overrides applied. This
+// gives access to fields that the models of this package do not have. Entries
+// are matched on their id field, or for items with variants, the internal-id
+// of any variant; for those the entry is the array of all its variants. An
+// error is returned if the request failed, a non 200 error code was returned,
+// or no entry has the ID.
+func (c *Client) GetRaw(ctx context.Context, category Category, id int) (json.RawMessage, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	if _, ok := listEndpoints[category]; !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCategory, category)
+	}
+	description := string(category) + " list"
+	body, err := c.fetchRawList(ctx, category, description)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", description, err)
+	}
+	var entries map[string]json.RawMessage
+	if err := jsonUnmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", description, err)
+	}
+	want := strconv.Itoa(id)
+	for _, entry := range entries {
+		objects, _ := entryObjects(entry)
+		for _, object := range objects {
+			if string(object["id"]) == want || string(object["internal-id"]) == want {
+				return entry, nil
+			}
+		}
+	}
+	return nil, ErrNotFound
+}
@@ -0,0 +1,108 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Recipe represents a DIY recipe. The AC:NH API does not provide recipes, so
+// they are supplied by a RecipeProvider backed by another data source.
+type Recipe struct {
+	ID          int
+	Name        string
+	CraftedItem string
+	SellPrice   int
+	Materials   []RecipeMaterial
+	Sources     []string
+	ImageURI    string
+}
+
+// RecipeMaterial is an item, and the number of it, needed to craft a recipe.
+type RecipeMaterial struct {
+	Name  string
+	Count int
+}
+
+// RecipeProvider supplies DIY recipes from a data source other than the AC:NH
+// API.
+type RecipeProvider interface {
+	Recipes(ctx context.Context) ([]*Recipe, error)
+}
+
+// WithRecipeProvider sets the data source used by the recipe methods.
+func WithRecipeProvider(provider RecipeProvider) Option {
+	return func(c *Client) {
+		c.recipeProvider = provider
+	}
+}
+
+// RecipeList returns all the recipes that the configured recipe provider
+// supplies. An error is returned if no provider is configured or the provider
+// failed. Use RecipeListContext to control cancellation and deadlines.
+func (c *Client) RecipeList() ([]*Recipe, error) {
+	return c.RecipeListContext(context.Background())
+}
+
+// RecipeListContext is like RecipeList but makes its requests with the given
+// context.
+func (c *Client) RecipeListContext(ctx context.Context) ([]*Recipe, error) {
+	if c.recipeProvider == nil {
+		return nil, fmt.Errorf("no recipe provider configured")
+	}
+	recipeList, err := c.recipeProvider.Recipes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipe list: %w", err)
+	}
+	return recipeList, nil
+}
+
+// RecipeByName gets a recipe based on its name, ignoring case. An error is
+// returned if no provider is configured, the provider failed, or no match was
+// found. Use RecipeByNameContext to control cancellation and deadlines.
+func (c *Client) RecipeByName(name string) (*Recipe, error) {
+	return c.RecipeByNameContext(context.Background(), name)
+}
+
+// RecipeByNameContext is like RecipeByName but makes its requests with the
+// given context.
+func (c *Client) RecipeByNameContext(ctx context.Context, name string) (*Recipe, error) {
+	recipeList, err := c.RecipeListContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, recipe := range recipeList {
+		if strings.EqualFold(recipe.Name, name) {
+			return recipe, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// RecipeCraftedItem gets the variants of the houseware, wall-mounted or misc
+// item that the given recipe crafts. An error is returned if a request failed
+// or a non 200 error code was returned or no match was found. Use
+// RecipeCraftedItemContext to control cancellation and deadlines.
+func (c *Client) RecipeCraftedItem(recipe *Recipe) ([]*ItemVariant, error) {
+	return c.RecipeCraftedItemContext(context.Background(), recipe)
+}
+
+// RecipeCraftedItemContext is like RecipeCraftedItem but makes its requests
+// with the given context.
+func (c *Client) RecipeCraftedItemContext(ctx context.Context, recipe *Recipe) ([]*ItemVariant, error) {
+	name := recipe.CraftedItem
+	if name == "" {
+		name = recipe.Name
+	}
+	if item, err := c.HousewareByNameContext(ctx, name); err == nil {
+		return item.Variants, nil
+	}
+	if item, err := c.WallMountedByNameContext(ctx, name); err == nil {
+		return item.Variants, nil
+	}
+	item, err := c.MiscItemByNameContext(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return item.Variants, nil
+}
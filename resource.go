@@ -0,0 +1,277 @@
+package goacnh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// resource describes a category of entities served by the API, so that every
+// category is listed and looked up the same way and adding one only takes its
+// model and a definition.
+type resource[T any] struct {
+	category Category
+	// description names a single entity in errors, such as "fish".
+	description string
+	// listDescription names the list in errors, such as "fish list".
+	listDescription string
+	list            endpoint
+	// stream decodes a list body, passing each entity to yield until it
+	// returns false.
+	stream func(body io.Reader, mask *fieldMask, yield func(*T) bool) error
+	// item is the endpoint that serves a single entity, whose ID is given in
+	// the path parameter named by idParam. It is only used by resources that
+	// can be looked up by ID.
+	item    endpoint
+	idParam string
+	id      func(entity *T) int
+	// names returns the names an entity can be found by, of which there are
+	// several for items with variants. It is nil for resources whose entities
+	// have no names, such as background music, which are never found by name.
+	names func(entity *T) []map[string]string
+}
+
+// variantStream returns a stream function for resources served as lists of
+// item variants, which builds each item with build.
+func variantStream[T any](build func(name string, variants []*ItemVariant) *T) func(io.Reader, *fieldMask, func(*T) bool) error {
+	return func(body io.Reader, mask *fieldMask, yield func(*T) bool) error {
+		return streamVariantList(body, mask, build, yield)
+	}
+}
+
+// variantNames returns the names of each of an item's variants.
+func variantNames(variants []*ItemVariant) []map[string]string {
+	names := make([]map[string]string, 0, len(variants))
+	for _, variant := range variants {
+		names = append(names, variant.Name)
+	}
+	return names
+}
+
+// listAll returns every entity of the resource, from the client's cache if it
+// holds a fresh copy, followed by the custom entries of its category.
+func (r *resource[T]) listAll(ctx context.Context, c *Client) ([]*T, error) {
+	list, err := r.officialList(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return append(list, customValues[T](c, r.category)...), nil
+}
+
+// officialList returns every entity of the resource served by the API or the
+// client's source, from the client's cache if it holds a fresh copy.
+func (r *resource[T]) officialList(ctx context.Context, c *Client) ([]*T, error) {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	if list, ok := cachedList[T](c, r.list); ok {
+		return list, nil
+	}
+	body, err := c.fetchList(ctx, r.category, r.listDescription)
+	if err != nil {
+		return staleList[T](c, r.list, err)
+	}
+	defer body.Close()
+	var list []*T
+	withProfileLabels(ctx, r.list.path, decodePhase, func() {
+		list, err = collect(body, c.fieldMask, r.stream)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", r.listDescription, err)
+	}
+	storeList(c, r.list, list)
+	return list, nil
+}
+
+// each passes every entity of the resource, followed by the custom entries of
+// its category, to yield in turn until it returns false, decoding them one at
+// a time rather than collecting them first. Lists held by the client's cache
+// are used as they are, but streamed lists are not stored in it. The decoding
+// is not given profile labels, as the caller's own work runs within yield.
+func (r *resource[T]) each(ctx context.Context, c *Client, yield func(*T) bool) error {
+	stopped := false
+	official := func(entity *T) bool {
+		stopped = !yield(entity)
+		return !stopped
+	}
+	if err := r.eachOfficial(ctx, c, official); err != nil || stopped {
+		return err
+	}
+	yieldAll(customValues[T](c, r.category), yield)
+	return nil
+}
+
+// eachOfficial is like each but only passes the entities served by the API or
+// the client's source.
+func (r *resource[T]) eachOfficial(ctx context.Context, c *Client, yield func(*T) bool) error {
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	if list, ok := cachedList[T](c, r.list); ok {
+		yieldAll(list, yield)
+		return nil
+	}
+	body, err := c.fetchList(ctx, r.category, r.listDescription)
+	if err != nil {
+		list, err := staleList[T](c, r.list, err)
+		if err != nil {
+			return err
+		}
+		yieldAll(list, yield)
+		return nil
+	}
+	defer body.Close()
+	if err := r.stream(body, c.fieldMask, yield); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", r.listDescription, err)
+	}
+	return nil
+}
+
+func yieldAll[T any](list []*T, yield func(*T) bool) {
+	for _, entity := range list {
+		if !yield(entity) {
+			break
+		}
+	}
+}
+
+// byID returns the entity of the resource with the given ID, which is either
+// a custom entry of its category or requested from the API, or found in the
+// list for clients that look entities up in it.
+func (r *resource[T]) byID(ctx context.Context, c *Client, id int) (*T, error) {
+	if entity, ok := customValue[T](c, r.category, id); ok {
+		return entity, nil
+	}
+	ctx, cancel := withTimeout(ctx, c.timeout)
+	defer cancel()
+	if c.byIDFromList() {
+		list, err := r.listAll(ctx, c)
+		var entity *T
+		withProfileLabels(ctx, r.list.path, indexPhase, func() {
+			entity, err = entityByID(list, err, id, r.id)
+		})
+		return entity, err
+	}
+	var entity *T
+	var resp *resty.Response
+	var err error
+	withProfileLabels(ctx, r.item.path, fetchPhase, func() {
+		resp, err = c.restClient.R().
+			SetContext(ctx).
+			SetHeader("Accept", "application/json").
+			SetPathParam("apiVersion", strconv.Itoa(apiVersion)).
+			SetPathParam(r.idParam, strconv.Itoa(id)).
+			SetResult(&entity).
+			Get(r.item.path)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s: %w", r.description, err)
+	}
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// byIDs looks up the entity with each of the given IDs, making up to the
+// client's concurrency limit of requests at once, or finding them all in a
+// single copy of the list for clients that look entities up in it. The
+// entities are returned in the order of their IDs, with nil in place of those
+// that failed, whose errors are returned in a BatchError.
+func (r *resource[T]) byIDs(ctx context.Context, c *Client, ids []int) ([]*T, error) {
+	entities := make([]*T, len(ids))
+	errs := make([]error, len(ids))
+	if c.byIDFromList() {
+		list, err := r.listAll(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		withProfileLabels(ctx, r.list.path, indexPhase, func() {
+			for i, id := range ids {
+				entities[i], errs[i] = entityByID(list, nil, id, r.id)
+			}
+		})
+	} else {
+		c.concurrently(len(ids), func(i int) {
+			entities[i], errs[i] = r.byID(ctx, c, ids[i])
+		})
+	}
+	var batchErr *BatchError
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if batchErr == nil {
+			batchErr = &BatchError{Errors: make(map[int]error)}
+		}
+		batchErr.Errors[ids[i]] = err
+	}
+	if batchErr != nil {
+		return entities, batchErr
+	}
+	return entities, nil
+}
+
+// concurrently calls fn with each index up to n, running up to the client's
+// concurrency limit of calls at once, and returns once they have all returned.
+func (c *Client) concurrently(n int, fn func(i int)) {
+	limit := c.concurrency
+	if limit < 1 {
+		limit = 1
+	}
+	slots := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// byName returns the first entity of the resource with a name that matches
+// the given one, ignoring case, in any of the client's languages, or failing
+// that in any other language. ErrNotFound is returned without making any
+// requests if the resource's entities have no names.
+func (r *resource[T]) byName(ctx context.Context, c *Client, name string) (*T, error) {
+	if r.names == nil {
+		return nil, ErrNotFound
+	}
+	list, err := r.listAll(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var found *T
+	withProfileLabels(ctx, r.list.path, indexPhase, func() {
+		found = r.findByName(c, list, name)
+	})
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
+
+// findByName returns the entity in list with the given name, or nil if there
+// is none. Names in the client's languages are preferred, so that a name in
+// another language cannot shadow an entity found by its name in one of them.
+func (r *resource[T]) findByName(c *Client, list []*T, name string) *T {
+	if r.names == nil {
+		return nil
+	}
+	for _, anyLanguage := range []bool{false, true} {
+		for _, entity := range list {
+			for _, names := range r.names(entity) {
+				if c.hasName(names, name, anyLanguage) {
+					return entity
+				}
+			}
+		}
+	}
+	return nil
+}
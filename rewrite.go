@@ -0,0 +1,28 @@
+package goacnh
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// URLRewriter is given the full URL of each request before it is sent and
+// returns the URL to send it to instead. It may modify and return the URL it
+// is given. This allows, for example, image and audio requests to be routed to
+// a CDN while JSON requests still go to the API.
+type URLRewriter func(u *url.URL) *url.URL
+
+// WithURLRewriter sets a function to rewrite the URL of every request before
+// it is sent.
+func WithURLRewriter(rewrite URLRewriter) Option {
+	return func(c *Client) {
+		c.urlRewriter = rewrite
+	}
+}
+
+func (c *Client) rewriteURL(_ *resty.Client, r *http.Request) error {
+	r.URL = c.urlRewriter(r.URL)
+	r.Host = r.URL.Host
+	return nil
+}
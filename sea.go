@@ -0,0 +1,88 @@
+package goacnh
+
+import "context"
+
+// SeaCreature represents a sea creature that can be caught while diving, as
+// represented via the API
+type SeaCreature struct {
+	ID           int               `json:"id"`
+	FileName     string            `json:"file-name"`
+	Name         map[string]string `json:"name"`
+	Availability Availability      `json:"availability"`
+	Speed        string            `json:"speed"`
+	Shadow       string            `json:"shadow"`
+	Price        int               `json:"price"`
+	CatchPhrase  string            `json:"catch-phrase"`
+	MuseumPhrase string            `json:"museum-phrase"`
+	ImageURI     string            `json:"image_uri"`
+	IconURI      string            `json:"icon_uri"`
+}
+
+var seaCreatureResource = &resource[SeaCreature]{
+	category:        SeaCreatureCategory,
+	description:     "sea creature",
+	listDescription: "sea creature list",
+	list:            seaCreatureListEndpoint,
+	stream:          streamList[SeaCreature],
+	item:            seaCreatureEndpoint,
+	idParam:         "seaCreatureID",
+	id:              func(s *SeaCreature) int { return s.ID },
+	names:           func(s *SeaCreature) []map[string]string { return []map[string]string{s.Name} },
+}
+
+// SeaCreatureList returns all the sea creatures that the API provides. An error
+// is returned if the request failed or a non 200 error code was returned. Use
+// SeaCreatureListContext to control cancellation and deadlines.
+func (c *Client) SeaCreatureList() ([]*SeaCreature, error) {
+	return c.SeaCreatureListContext(context.Background())
+}
+
+// SeaCreatureListContext is like SeaCreatureList but makes its requests with
+// the given context.
+func (c *Client) SeaCreatureListContext(ctx context.Context) ([]*SeaCreature, error) {
+	return seaCreatureResource.listAll(ctx, c)
+}
+
+// SeaCreatureByID gets a single sea creature based on the ID provided. An error
+// is returned if the request failed or a non 200 error code was returned. Use
+// SeaCreatureByIDContext to control cancellation and deadlines.
+func (c *Client) SeaCreatureByID(id int) (*SeaCreature, error) {
+	return c.SeaCreatureByIDContext(context.Background(), id)
+}
+
+// SeaCreatureByIDContext is like SeaCreatureByID but makes its requests with
+// the given context.
+func (c *Client) SeaCreatureByIDContext(ctx context.Context, id int) (*SeaCreature, error) {
+	return seaCreatureResource.byID(ctx, c, id)
+}
+
+// SeaCreaturesByIDs gets the sea creatures with each of the given IDs, making
+// several requests at once, up to the limit set by WithConcurrency. They are
+// returned in the order of their IDs. If any could not be got, the rest are
+// still returned, with nil in place of those that failed, along with a
+// *BatchError holding the error of each. Use SeaCreaturesByIDsContext to
+// control cancellation and deadlines.
+func (c *Client) SeaCreaturesByIDs(ids []int) ([]*SeaCreature, error) {
+	return c.SeaCreaturesByIDsContext(context.Background(), ids)
+}
+
+// SeaCreaturesByIDsContext is like SeaCreaturesByIDs but makes its requests
+// with the given context.
+func (c *Client) SeaCreaturesByIDsContext(ctx context.Context, ids []int) ([]*SeaCreature, error) {
+	return seaCreatureResource.byIDs(ctx, c, ids)
+}
+
+// SeaCreatureByName get a sea creature based on its name. The name is compared
+// with its names in each of the client's languages, and then in any other
+// language. An error is returned if the request failed or a non 200 error code
+// was returned or no match was found. Use SeaCreatureByNameContext to control
+// cancellation and deadlines.
+func (c *Client) SeaCreatureByName(name string) (*SeaCreature, error) {
+	return c.SeaCreatureByNameContext(context.Background(), name)
+}
+
+// SeaCreatureByNameContext is like SeaCreatureByName but makes its requests
+// with the given context.
+func (c *Client) SeaCreatureByNameContext(ctx context.Context, name string) (*SeaCreature, error) {
+	return seaCreatureResource.byName(ctx, c, name)
+}
@@ -0,0 +1,87 @@
+package goacnh
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+)
+
+// snapshotManifestName is the name of the file WriteSnapshot records a
+// snapshot's version in.
+const snapshotManifestName string = "snapshot.json"
+
+type snapshotManifest struct {
+	Hash    string    `json:"hash"`
+	Created time.Time `json:"created"`
+}
+
+// SnapshotVersion identifies the build of a snapshot of the API that a client
+// created with WithOfflineData reads from.
+type SnapshotVersion struct {
+	// Hash is a SHA-256 hash of the contents of every category in the
+	// snapshot, given in hex. Snapshots with the same data have the same hash,
+	// whenever they were written.
+	Hash string
+	// Created is when WriteSnapshot wrote the snapshot, or the zero time for
+	// snapshots without a snapshot.json file.
+	Created time.Time
+	// Modified reports whether the snapshot's data has changed since it was
+	// written, as its hash no longer matches the one WriteSnapshot recorded.
+	Modified bool
+}
+
+// SnapshotVersion reports the version of the snapshot the client reads from,
+// hashing its contents so that it identifies the data actually being served.
+// An error is returned if the client was not created with WithOfflineData, or
+// the snapshot could not be read.
+func (c *Client) SnapshotVersion() (*SnapshotVersion, error) {
+	if c.offlineData == nil {
+		return nil, fmt.Errorf("no offline data configured")
+	}
+	contents := make(map[Category][]byte)
+	for _, category := range listCategories() {
+		data, err := fs.ReadFile(c.offlineData, string(category)+".json")
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s data: %w", category, err)
+		}
+		contents[category] = data
+	}
+	version := &SnapshotVersion{Hash: snapshotHash(contents)}
+	data, err := fs.ReadFile(c.offlineData, snapshotManifestName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return version, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot manifest: %w", err)
+	}
+	var manifest snapshotManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot manifest: %w", err)
+	}
+	version.Created = manifest.Created
+	version.Modified = manifest.Hash != version.Hash
+	return version, nil
+}
+
+// snapshotHash hashes the contents of the categories of a snapshot, in order
+// of category, so that the hash does not depend on when or how the snapshot
+// was written.
+func snapshotHash(contents map[Category][]byte) string {
+	hash := sha256.New()
+	for _, category := range listCategories() {
+		data, ok := contents[category]
+		if !ok {
+			continue
+		}
+		sum := sha256.Sum256(data)
+		fmt.Fprintf(hash, "%s %s\n", category, hex.EncodeToString(sum[:]))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,233 @@
+package goacnh
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Source provides the raw JSON of whole categories, in the shape the API
+// serves them: an object of entries keyed by their file name. A Source that
+// has no data for a category returns an error matching ErrNotFound.
+type Source interface {
+	CategoryJSON(ctx context.Context, category Category) ([]byte, error)
+}
+
+// WithSource makes the client read every list, and every entity looked up by
+// ID, from the given source instead of the API. Images and audio files are
+// still downloaded from the API. Combined with MergeSources, this allows data
+// from the API to be overlaid with corrections or data from elsewhere, and with
+// FallbackSources, data to be read from elsewhere when the API is unavailable.
+func WithSource(source Source) Option {
+	return func(c *Client) {
+		c.source = source
+		c.sourceOptions = append(c.sourceOptions, "WithSource")
+	}
+}
+
+// CategoryJSON fetches the raw JSON of a whole category from the API, so that
+// a Client can be used as a Source. It always makes a request, even if the
+// client was given a Source of its own.
+func (c *Client) CategoryJSON(ctx context.Context, category Category) ([]byte, error) {
+	result, err := c.ListSince(ctx, category, "")
+	if err != nil {
+		return nil, err
+	}
+	return result.Body, nil
+}
+
+// FSSource returns a Source that reads each category from a file in fsys
+// named after it, such as fish.json. Categories without a file have no data.
+func FSSource(fsys fs.FS) Source {
+	return fsSource{fsys: fsys}
+}
+
+type fsSource struct {
+	fsys fs.FS
+}
+
+func (s fsSource) CategoryJSON(_ context.Context, category Category) ([]byte, error) {
+	data, err := fs.ReadFile(s.fsys, string(category)+".json")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no %s data: %w", category, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s data: %w", category, err)
+	}
+	return data, nil
+}
+
+// MergeSources returns a Source that overlays the given sources, in order of
+// precedence. Entries are merged field by field: a field given by an earlier
+// source replaces the same field from later ones, objects are merged
+// recursively, and entries or fields missing from earlier sources are taken
+// from later ones. Sources without data for a category are skipped; any other
+// error is returned.
+func MergeSources(sources ...Source) Source {
+	return mergedSource(sources)
+}
+
+type mergedSource []Source
+
+func (s mergedSource) CategoryJSON(ctx context.Context, category Category) ([]byte, error) {
+	var merged json.RawMessage
+	for i := len(s) - 1; i >= 0; i-- {
+		data, err := s[i].CategoryJSON(ctx, category)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if merged == nil {
+			merged = data
+			continue
+		}
+		if merged, err = mergeJSON(data, merged); err != nil {
+			return nil, fmt.Errorf("failed to merge %s data: %w", category, err)
+		}
+	}
+	if merged == nil {
+		return nil, fmt.Errorf("no %s data: %w", category, ErrNotFound)
+	}
+	return merged, nil
+}
+
+// FallbackSources returns a Source that reads each category from the first of
+// the given sources that provides it, trying the next whenever one fails, so
+// that, for example, the API can fall back to a snapshot while it is down. The
+// error of the last source is returned if none provide the category.
+func FallbackSources(sources ...Source) Source {
+	return fallbackSource(sources)
+}
+
+type fallbackSource []Source
+
+func (s fallbackSource) CategoryJSON(ctx context.Context, category Category) ([]byte, error) {
+	err := fmt.Errorf("no %s data: %w", category, ErrNotFound)
+	for _, source := range s {
+		var data []byte
+		if data, err = source.CategoryJSON(ctx, category); err == nil {
+			return data, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// mergeJSON overlays two JSON values. If both are objects, their fields are
+// merged recursively with those of over taking precedence, otherwise over
+// replaces under.
+func mergeJSON(over json.RawMessage, under json.RawMessage) (json.RawMessage, error) {
+	var overFields, underFields map[string]json.RawMessage
+	if jsonUnmarshal(over, &overFields) != nil || overFields == nil ||
+		jsonUnmarshal(under, &underFields) != nil || underFields == nil {
+		return over, nil
+	}
+	for key, value := range overFields {
+		if existing, ok := underFields[key]; ok {
+			merged, err := mergeJSON(value, existing)
+			if err != nil {
+				return nil, err
+			}
+			value = merged
+		}
+		underFields[key] = value
+	}
+	return json.Marshal(underFields)
+}
+
+// fetchList returns the body of the list of a category, read from the
+// client's source if it has one and requested from the API otherwise, with
+// any overrides applied. Concurrent calls for the same category share a
+// single request, which is only cancelled once all of their contexts are done.
+// The description names the list in errors.
+func (c *Client) fetchList(ctx context.Context, category Category, description string) (io.ReadCloser, error) {
+	data, err := c.listFlights.do(ctx, category, func(ctx context.Context) ([]byte, error) {
+		body, err := c.fetchRawList(ctx, category, description)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", description, err)
+		}
+		if data, err = c.overrides.apply(category, data); err != nil {
+			return nil, fmt.Errorf("failed to apply overrides to %s: %w", description, err)
+		}
+		return data, nil
+	})
+	if err != nil {
+		if err == ctx.Err() {
+			return nil, fmt.Errorf("failed to request %s: %w", description, err)
+		}
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (c *Client) fetchRawList(ctx context.Context, category Category, description string) (io.ReadCloser, error) {
+	listEndpoint := listEndpoints[category]
+	var err error
+	if c.source != nil {
+		var data []byte
+		withProfileLabels(ctx, listEndpoint.path, fetchPhase, func() {
+			data, err = c.source.CategoryJSON(ctx, category)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", description, err)
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	var resp *resty.Response
+	withProfileLabels(ctx, listEndpoint.path, fetchPhase, func() {
+		resp, err = c.restClient.R().
+			SetContext(ctx).
+			SetHeader("Accept", "application/json").
+			SetPathParam("apiVersion", strconv.Itoa(apiVersion)).
+			SetDoNotParseResponse(true).
+			Get(listEndpoint.path)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request %s: %w", description, err)
+	}
+	if err := checkResponse(resp); err != nil {
+		resp.RawBody().Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{responseBody(resp), resp.RawBody()}, nil
+}
+
+// byIDFromList reports whether entities looked up by ID should be found in
+// their category's list, rather than requested from the API, because the list
+// comes from a source or has overrides applied.
+func (c *Client) byIDFromList() bool {
+	return c.source != nil || c.overrides != nil
+}
+
+// entityByID finds the entity with the given ID in a list, for clients that
+// look up entities in their lists.
+func entityByID[T any](list []*T, err error, id int, idOf func(*T) int) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range list {
+		if idOf(entity) == id {
+			return entity, nil
+		}
+	}
+	return nil, ErrNotFound
+}
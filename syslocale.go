@@ -0,0 +1,151 @@
+package goacnh
+
+import (
+	"os"
+	"strings"
+)
+
+// southernCountries holds the ISO 3166 codes of countries that lie mostly in
+// the southern hemisphere.
+var southernCountries = map[string]bool{
+	"AO": true, "AR": true, "AU": true, "BO": true, "BR": true, "BW": true,
+	"CL": true, "FJ": true, "FK": true, "LS": true, "MG": true, "MU": true,
+	"MW": true, "MZ": true, "NA": true, "NC": true, "NZ": true, "PE": true,
+	"PY": true, "RE": true, "SZ": true, "TO": true, "UY": true, "VU": true,
+	"WS": true, "ZA": true, "ZM": true, "ZW": true,
+}
+
+// southernZonePrefixes holds prefixes of IANA time zone names for places in
+// the southern hemisphere.
+var southernZonePrefixes = []string{
+	"Antarctica/", "Australia/", "America/Argentina/", "America/Asuncion",
+	"America/Montevideo", "America/Santiago", "America/Punta_Arenas",
+	"America/Sao_Paulo", "America/Bahia", "America/Recife", "America/Maceio",
+	"America/Fortaleza", "America/Belem", "America/Araguaina",
+	"America/Santarem", "America/Manaus", "America/Cuiaba",
+	"America/Campo_Grande", "America/Porto_Velho", "America/Rio_Branco",
+	"America/Eirunepe", "America/Noronha", "America/La_Paz", "America/Lima",
+	"Atlantic/Stanley", "Africa/Johannesburg", "Africa/Maputo",
+	"Africa/Windhoek", "Africa/Harare", "Africa/Lusaka", "Africa/Gaborone",
+	"Africa/Maseru", "Africa/Mbabane", "Africa/Luanda", "Africa/Blantyre",
+	"Indian/Mauritius", "Indian/Antananarivo", "Indian/Reunion",
+	"Pacific/Auckland", "Pacific/Chatham", "Pacific/Fiji", "Pacific/Noumea",
+	"Pacific/Tongatapu", "Pacific/Efate", "Pacific/Apia", "NZ",
+}
+
+// WithSystemLocale sets the client's language and hemisphere from the locale
+// and time zone of the operating system, as given by the LC_ALL, LC_MESSAGES,
+// LANG and TZ environment variables or the system time zone. Settings that
+// cannot be detected are left unchanged, and options given after it, such as
+// WithLocale or WithHemisphere, take precedence.
+func WithSystemLocale() Option {
+	return func(c *Client) {
+		language, country := systemLocale()
+		if code := languageCode(language, country); code != "" {
+			c.languages = []string{code}
+		}
+		if hemisphere, ok := systemHemisphere(country); ok {
+			c.hemisphere = hemisphere
+		}
+	}
+}
+
+// WithHemisphere sets the hemisphere returned by Client.Hemisphere. The
+// default is NorthernHemisphere.
+func WithHemisphere(hemisphere Hemisphere) Option {
+	return func(c *Client) {
+		c.hemisphere = hemisphere
+	}
+}
+
+// systemLocale returns the lower-case language and upper-case country of the
+// POSIX locale in the environment, such as "en" and "AU" for en_AU.UTF-8.
+func systemLocale() (string, string) {
+	var locale string
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if locale = os.Getenv(name); locale != "" {
+			break
+		}
+	}
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return "", ""
+	}
+	language, country, _ := strings.Cut(strings.ReplaceAll(locale, "-", "_"), "_")
+	return strings.ToLower(language), strings.ToUpper(country)
+}
+
+// languageCode returns the API language code that best matches a language and
+// country, or an empty string if the API has no names in that language.
+func languageCode(language string, country string) string {
+	switch language {
+	case "en":
+		if country == "US" || country == "CA" {
+			return "USen"
+		}
+		return "EUen"
+	case "fr":
+		if country == "CA" {
+			return "USfr"
+		}
+		return "EUfr"
+	case "es":
+		if country == "" || country == "ES" {
+			return "EUes"
+		}
+		return "USes"
+	case "de", "it", "nl", "ru":
+		return "EU" + language
+	case "ja":
+		return "JPja"
+	case "ko":
+		return "KRko"
+	case "zh":
+		if country == "TW" || country == "HK" || country == "MO" {
+			return "TWzh"
+		}
+		return "CNzh"
+	}
+	return ""
+}
+
+// systemHemisphere guesses the hemisphere from the system time zone, falling
+// back to the given locale country when the time zone is not known to be in
+// the southern hemisphere. The northern hemisphere is assumed if neither is
+// known to be southern, and not reported if neither was found.
+func systemHemisphere(country string) (Hemisphere, bool) {
+	// UTC and the fixed Etc zones say nothing about where the system is.
+	zone := systemTimeZone()
+	if zone == "UTC" || zone == "GMT" || strings.HasPrefix(zone, "Etc/") {
+		zone = ""
+	}
+	if zone != "" {
+		for _, prefix := range southernZonePrefixes {
+			if strings.HasPrefix(zone, prefix) {
+				return SouthernHemisphere, true
+			}
+		}
+	}
+	if southernCountries[country] {
+		return SouthernHemisphere, true
+	}
+	return NorthernHemisphere, zone != "" || country != ""
+}
+
+// systemTimeZone returns the IANA name of the system time zone, or an empty
+// string if it cannot be found.
+func systemTimeZone() string {
+	if zone := strings.TrimPrefix(os.Getenv("TZ"), ":"); zone != "" {
+		return zone
+	}
+	target, err := os.Readlink("/etc/localtime")
+	if err != nil {
+		return ""
+	}
+	if _, zone, ok := strings.Cut(target, "zoneinfo/"); ok {
+		return zone
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+//go:build !notelemetry
+
+package goacnh
+
+import (
+	"context"
+	"net/http"
+	"runtime/pprof"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	fetchPhase  string = "fetch"
+	decodePhase string = "decode"
+	indexPhase  string = "index"
+)
+
+// withProfileLabels runs fn with pprof labels naming the endpoint and phase of
+// the work being done, so CPU profiles of programs using this package can tell
+// time spent waiting on the API apart from time spent decoding its responses
+// and searching the decoded lists.
+func withProfileLabels(ctx context.Context, endpoint, phase string, fn func()) {
+	labels := pprof.Labels("goacnh_endpoint", endpoint, "goacnh_phase", phase)
+	pprof.Do(ctx, labels, func(context.Context) {
+		fn()
+	})
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf("goacnh: "+format, v...)
+	}
+}
+
+// loggingTransport is a round tripper that logs every request that reaches
+// it, including each attempt of a retried request.
+type loggingTransport struct {
+	client *Client
+	next   http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.client.logf("%s %s", req.Method, req.URL)
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.client.logf("%s %s failed after %s: %v", req.Method, req.URL, time.Since(start), err)
+		return nil, err
+	}
+	t.client.logf("%s %s: %s in %s", req.Method, req.URL, resp.Status, time.Since(start))
+	return resp, nil
+}
+
+func (c *Client) logRetry(resp *resty.Response, err error) {
+	if resp == nil || resp.Request == nil || resp.Request.Attempt > c.retryCount {
+		return
+	}
+	if err != nil {
+		c.logf("retrying %s %s after attempt %d: %v", resp.Request.Method, resp.Request.URL, resp.Request.Attempt, err)
+		return
+	}
+	c.logf("retrying %s %s after attempt %d: %s", resp.Request.Method, resp.Request.URL, resp.Request.Attempt, resp.Status())
+}
+
+type endpointContextKey struct{}
+
+// tagEndpoint records the unexpanded path of a request in its context, before
+// resty fills in its path parameters, so that the metrics transport can label
+// the request with the endpoint it was made to.
+func tagEndpoint(_ *resty.Client, r *resty.Request) error {
+	r.SetContext(context.WithValue(r.Context(), endpointContextKey{}, r.URL))
+	return nil
+}
+
+func requestEndpoint(req *http.Request) string {
+	if endpoint, ok := req.Context().Value(endpointContextKey{}).(string); ok {
+		return endpoint
+	}
+	return req.URL.Path
+}
+
+// metricsTransport is a round tripper that reports every request that reaches
+// it to a MetricsHook.
+type metricsTransport struct {
+	hook MetricsHook
+	next http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	t.hook.ObserveRequest(requestEndpoint(req), statusCode, time.Since(start), err)
+	return resp, err
+}
+
+func (c *Client) observeCache(cache string, hit bool) {
+	if c.metrics != nil {
+		c.metrics.ObserveCache(cache, hit)
+	}
+}
+
+func (c *Client) observeDownload(endpoint string, bytes int64) {
+	if c.metrics != nil {
+		c.metrics.ObserveDownload(endpoint, bytes)
+	}
+}
+
+// useMetricsTransport wraps the client's transport in one that reports each
+// request to its metrics hook, if it has one.
+func (c *Client) useMetricsTransport() {
+	if c.metrics != nil {
+		c.restClient.OnBeforeRequest(tagEndpoint)
+		c.restClient.SetTransport(&metricsTransport{hook: c.metrics, next: c.baseTransport()})
+	}
+}
+
+// useLoggingTransport wraps the client's transport in one that logs each
+// request, if the client has a logger.
+func (c *Client) useLoggingTransport() {
+	if c.logger != nil {
+		c.restClient.SetTransport(&loggingTransport{client: c, next: c.baseTransport()})
+	}
+}
+
+// useRetryLogging logs each retry, if the client has a logger.
+func (c *Client) useRetryLogging() {
+	if c.logger != nil {
+		c.restClient.AddRetryHook(c.logRetry)
+	}
+}
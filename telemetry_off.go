@@ -0,0 +1,30 @@
+//go:build notelemetry
+
+package goacnh
+
+import "context"
+
+// This build has no instrumentation: nothing is logged, nothing is reported to
+// a MetricsHook, and no pprof labels are set, whatever options are given.
+
+const (
+	fetchPhase  string = "fetch"
+	decodePhase string = "decode"
+	indexPhase  string = "index"
+)
+
+func withProfileLabels(_ context.Context, _, _ string, fn func()) {
+	fn()
+}
+
+func (c *Client) logf(string, ...interface{}) {}
+
+func (c *Client) observeCache(string, bool) {}
+
+func (c *Client) observeDownload(string, int64) {}
+
+func (c *Client) useMetricsTransport() {}
+
+func (c *Client) useLoggingTransport() {}
+
+func (c *Client) useRetryLogging() {}
@@ -0,0 +1,265 @@
+package goacnh
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TimeFormatter renders availability windows and dates, such as birthdays, for
+// display in a given language. The language is an API language code such as
+// USen, EUfr or JPja; unknown languages are rendered in English.
+type TimeFormatter struct {
+	Language string
+	Clock24  bool
+}
+
+// timeLocale holds the words and layouts used to render times in a language.
+type timeLocale struct {
+	months  [12]string
+	allDay  string
+	allYear string
+	// date lays out a day of a month, given the month name, month number and
+	// day.
+	date func(monthName string, month, day int) string
+	// hour12 lays out an hour of a 12-hour clock, given the hour (1-12) and
+	// whether it is after noon.
+	hour12 func(hour int, pm bool) string
+}
+
+func westernHour12(hour int, pm bool) string {
+	if pm {
+		return fmt.Sprintf("%d PM", hour)
+	}
+	return fmt.Sprintf("%d AM", hour)
+}
+
+func dayMonthDate(monthName string, _ int, day int) string {
+	return fmt.Sprintf("%d %s", day, monthName)
+}
+
+var timeLocales = map[string]timeLocale{
+	"en": {
+		months:  [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		allDay:  "All day",
+		allYear: "All year",
+		date: func(monthName string, _ int, day int) string {
+			return fmt.Sprintf("%s %d", monthName, day)
+		},
+		hour12: westernHour12,
+	},
+	"de": {
+		months:  [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		allDay:  "Ganztägig",
+		allYear: "Ganzjährig",
+		date: func(monthName string, _ int, day int) string {
+			return fmt.Sprintf("%d. %s", day, monthName)
+		},
+		hour12: westernHour12,
+	},
+	"es": {
+		months:  [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		allDay:  "Todo el día",
+		allYear: "Todo el año",
+		date: func(monthName string, _ int, day int) string {
+			return fmt.Sprintf("%d de %s", day, monthName)
+		},
+		hour12: westernHour12,
+	},
+	"fr": {
+		months:  [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		allDay:  "Toute la journée",
+		allYear: "Toute l'année",
+		date:    dayMonthDate,
+		hour12:  westernHour12,
+	},
+	"it": {
+		months:  [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		allDay:  "Tutto il giorno",
+		allYear: "Tutto l'anno",
+		date:    dayMonthDate,
+		hour12:  westernHour12,
+	},
+	"nl": {
+		months:  [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		allDay:  "De hele dag",
+		allYear: "Het hele jaar",
+		date:    dayMonthDate,
+		hour12:  westernHour12,
+	},
+	"ru": {
+		months:  [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+		allDay:  "Весь день",
+		allYear: "Круглый год",
+		date: func(_ string, month int, day int) string {
+			genitive := [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"}
+			return fmt.Sprintf("%d %s", day, genitive[month-1])
+		},
+		hour12: westernHour12,
+	},
+	"ja": {
+		months:  [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		allDay:  "終日",
+		allYear: "通年",
+		date: func(_ string, month int, day int) string {
+			return fmt.Sprintf("%d月%d日", month, day)
+		},
+		hour12: func(hour int, pm bool) string {
+			if pm {
+				return fmt.Sprintf("午後%d時", hour)
+			}
+			return fmt.Sprintf("午前%d時", hour)
+		},
+	},
+	"zh": {
+		months:  [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		allDay:  "全天",
+		allYear: "全年",
+		date: func(_ string, month int, day int) string {
+			return fmt.Sprintf("%d月%d日", month, day)
+		},
+		hour12: func(hour int, pm bool) string {
+			if pm {
+				return fmt.Sprintf("下午%d点", hour)
+			}
+			return fmt.Sprintf("上午%d点", hour)
+		},
+	},
+	"ko": {
+		months:  [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		allDay:  "하루 종일",
+		allYear: "연중",
+		date: func(_ string, month int, day int) string {
+			return fmt.Sprintf("%d월 %d일", month, day)
+		},
+		hour12: func(hour int, pm bool) string {
+			if pm {
+				return fmt.Sprintf("오후 %d시", hour)
+			}
+			return fmt.Sprintf("오전 %d시", hour)
+		},
+	},
+}
+
+// TimeFormatter returns a TimeFormatter for the first of the client's
+// languages, using a 24-hour clock if clock24 is set.
+func (c *Client) TimeFormatter(clock24 bool) TimeFormatter {
+	language := defaultLanguageCode
+	if len(c.languages) > 0 {
+		language = c.languages[0]
+	}
+	return TimeFormatter{Language: language, Clock24: clock24}
+}
+
+func (f TimeFormatter) locale() timeLocale {
+	code := strings.ToLower(f.Language)
+	if len(code) > 2 {
+		code = code[len(code)-2:]
+	}
+	if locale, ok := timeLocales[code]; ok {
+		return locale
+	}
+	return timeLocales["en"]
+}
+
+// Hours renders hours of the day (0-23), as given by the time-array of an
+// Availability, as a list of time windows such as "4 AM – 9 PM". Each window
+// ends at the end of its last hour, and windows that run past midnight are kept
+// whole. An empty list or one of all 24 hours is rendered as all day.
+func (f TimeFormatter) Hours(hours []int) string {
+	locale := f.locale()
+	runs := cyclicRuns(hours, 24)
+	if runs == nil {
+		return locale.allDay
+	}
+	windows := make([]string, 0, len(runs))
+	for _, run := range runs {
+		windows = append(windows, f.hour(run[0])+" – "+f.hour((run[1]+1)%24))
+	}
+	return strings.Join(windows, ", ")
+}
+
+// Months renders months of the year (1-12), as given by the month arrays of an
+// Availability, as a list of ranges such as "November – March". Ranges that
+// run past the end of the year are kept whole. An empty list or one of all 12
+// months is rendered as all year.
+func (f TimeFormatter) Months(months []int) string {
+	locale := f.locale()
+	zeroBased := make([]int, 0, len(months))
+	for _, month := range months {
+		zeroBased = append(zeroBased, month-1)
+	}
+	runs := cyclicRuns(zeroBased, 12)
+	if runs == nil {
+		return locale.allYear
+	}
+	ranges := make([]string, 0, len(runs))
+	for _, run := range runs {
+		if run[0] == run[1] {
+			ranges = append(ranges, locale.months[run[0]])
+		} else {
+			ranges = append(ranges, locale.months[run[0]]+" – "+locale.months[run[1]])
+		}
+	}
+	return strings.Join(ranges, ", ")
+}
+
+// Date renders a day of a month, such as a villager's birthday. An
+// out-of-range month is rendered as numbers.
+func (f TimeFormatter) Date(month int, day int) string {
+	if month < 1 || month > 12 {
+		return fmt.Sprintf("%d/%d", day, month)
+	}
+	locale := f.locale()
+	return locale.date(locale.months[month-1], month, day)
+}
+
+func (f TimeFormatter) hour(hour int) string {
+	if f.Clock24 {
+		return fmt.Sprintf("%02d:00", hour)
+	}
+	hour12 := hour % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+	return f.locale().hour12(hour12, hour >= 12)
+}
+
+// cyclicRuns groups values in [0, size) into runs of consecutive values,
+// treating size-1 and 0 as consecutive. Each run is given as its first and last
+// value. It returns nil if values is empty or covers every value.
+func cyclicRuns(values []int, size int) [][2]int {
+	present := make([]bool, size)
+	count := 0
+	for _, value := range values {
+		if value >= 0 && value < size && !present[value] {
+			present[value] = true
+			count++
+		}
+	}
+	if count == 0 || count == size {
+		return nil
+	}
+	// Start from the first value that follows a gap, so runs that wrap
+	// around are not split.
+	start := 0
+	for present[(start+size-1)%size] || !present[start] {
+		start++
+	}
+	runs := make([][2]int, 0)
+	for i := 0; i < size; i++ {
+		value := (start + i) % size
+		if !present[value] {
+			continue
+		}
+		if len(runs) > 0 && runs[len(runs)-1][1] == (value+size-1)%size {
+			runs[len(runs)-1][1] = value
+		} else {
+			runs = append(runs, [2]int{value, value})
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i][0] < runs[j][0]
+	})
+	return runs
+}
@@ -0,0 +1,101 @@
+package goacnh
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrInvalidOption matches any OptionError via errors.Is.
+var ErrInvalidOption = errors.New("invalid option")
+
+// OptionError is returned by NewE when the given options would make a client
+// that cannot work as configured, such as one with a base URL that is not an
+// HTTP URL, or options that replace one another.
+type OptionError struct {
+	// Option names the option at fault, such as "WithBaseURL".
+	Option string
+	Reason string
+}
+
+func (e *OptionError) Error() string {
+	return fmt.Sprintf("invalid option %s: %s", e.Option, e.Reason)
+}
+
+// Is reports whether target is ErrInvalidOption.
+func (e *OptionError) Is(target error) bool {
+	return target == ErrInvalidOption
+}
+
+// validate returns an OptionError for the first problem with the client's
+// options, or nil if there is none.
+func (c *Client) validate() error {
+	if reason := checkHTTPURL(c.baseURL); reason != "" {
+		return &OptionError{Option: "WithBaseURL", Reason: reason}
+	}
+	if c.assetBaseURL != "" {
+		if reason := checkHTTPURL(c.assetBaseURL); reason != "" {
+			return &OptionError{Option: "WithAssetBaseURL", Reason: reason}
+		}
+	}
+	if reason := checkHTTPURL(c.nookipediaURL); reason != "" {
+		return &OptionError{Option: "WithNookipediaBaseURL", Reason: reason}
+	}
+	if c.proxyURL != "" {
+		if proxyURL, err := url.Parse(c.proxyURL); err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return &OptionError{Option: "WithProxy", Reason: fmt.Sprintf("%q is not a proxy URL", c.proxyURL)}
+		}
+	}
+	if c.httpClient != nil && c.restClient != nil {
+		return &OptionError{Option: "WithHTTPClient", Reason: "it is replaced by WithRestyClient"}
+	}
+	if len(c.sourceOptions) > 1 {
+		return &OptionError{
+			Option: c.sourceOptions[0],
+			Reason: fmt.Sprintf("it is replaced by %s, as a client has one source", c.sourceOptions[len(c.sourceOptions)-1]),
+		}
+	}
+	if c.nookipediaKeyMissing {
+		return &OptionError{Option: "WithNookipedia", Reason: "no API key given"}
+	}
+	switch {
+	case c.timeout < 0:
+		return &OptionError{Option: "WithTimeout", Reason: "negative timeout"}
+	case c.downloadTimeout < 0:
+		return &OptionError{Option: "WithDownloadTimeout", Reason: "negative timeout"}
+	case c.retryCount < 0:
+		return &OptionError{Option: "WithRetry", Reason: "negative retry count"}
+	case c.retryCount > 0 && c.retryWaitTime < 0:
+		return &OptionError{Option: "WithRetry", Reason: "negative base delay"}
+	case c.retryCount > 0 && c.retryMaxWaitTime < c.retryWaitTime:
+		return &OptionError{Option: "WithRetry", Reason: "max delay is shorter than base delay"}
+	case c.concurrency < 1:
+		return &OptionError{Option: "WithConcurrency", Reason: "concurrency must be at least 1"}
+	case c.breaker != nil && c.breaker.cooldown <= 0:
+		return &OptionError{Option: "WithCircuitBreaker", Reason: "cooldown must be positive"}
+	case c.diskCache != nil && c.diskCache.dir == "":
+		return &OptionError{Option: "WithDiskCache", Reason: "no directory given"}
+	case len(c.languages) == 0:
+		return &OptionError{Option: "WithLanguages", Reason: "no language codes given"}
+	case c.hemisphere != NorthernHemisphere && c.hemisphere != SouthernHemisphere:
+		return &OptionError{Option: "WithHemisphere", Reason: fmt.Sprintf("unknown hemisphere %d", c.hemisphere)}
+	case c.overwritePolicy < OverwriteExisting || c.overwritePolicy > RenameIfExists:
+		return &OptionError{Option: "WithOverwritePolicy", Reason: fmt.Sprintf("unknown policy %d", c.overwritePolicy)}
+	}
+	return nil
+}
+
+// checkHTTPURL returns why rawURL is not an absolute HTTP or HTTPS URL, or an
+// empty string if it is one.
+func checkHTTPURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	switch {
+	case err != nil:
+		return err.Error()
+	case u.Scheme != "http" && u.Scheme != "https":
+		return fmt.Sprintf("%q is not an http or https URL", rawURL)
+	case u.Host == "":
+		return fmt.Sprintf("%q has no host", rawURL)
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package goacnh
+
+// ItemVariant is a single variant of a furniture item, as represented via the
+// API. Items that come in several colours or patterns have one variant for
+// each, and items without variations have exactly one. StackSize is only
+// provided for misc items.
+type ItemVariant struct {
+	InternalID          int               `json:"internal-id"`
+	VariantID           string            `json:"variant-id"`
+	FileName            string            `json:"file-name"`
+	Name                map[string]string `json:"name"`
+	Variant             string            `json:"variant"`
+	BodyVariant         string            `json:"body-variant"`
+	Pattern             string            `json:"pattern"`
+	PatternTitle        string            `json:"pattern-title"`
+	IsDIY               bool              `json:"isDIY"`
+	CanCustomizeBody    bool              `json:"canCustomizeBody"`
+	CanCustomizePattern bool              `json:"canCustomizePattern"`
+	KitCost             int               `json:"kit-cost"`
+	Color1              string            `json:"color-1"`
+	Color2              string            `json:"color-2"`
+	Size                string            `json:"size"`
+	Source              string            `json:"source"`
+	SourceDetail        string            `json:"source-detail"`
+	Version             string            `json:"version"`
+	HHAConcept1         string            `json:"hha-concept-1"`
+	HHAConcept2         string            `json:"hha-concept-2"`
+	HHASeries           string            `json:"hha-series"`
+	HHASet              string            `json:"hha-set"`
+	Tag                 string            `json:"tag"`
+	IsOutdoor           bool              `json:"isOutdoor"`
+	SpeakerType         string            `json:"speaker-type"`
+	LightingType        string            `json:"lighting-type"`
+	Catalog             string            `json:"catalog"`
+	BuyPrice            int               `json:"buy-price"`
+	SellPrice           int               `json:"sell-price"`
+	StackSize           int               `json:"stack-size"`
+	ImageURI            string            `json:"image_uri"`
+}
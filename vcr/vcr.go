@@ -0,0 +1,196 @@
+// Package vcr records the responses a goacnh client receives to a cassette
+// file and replays them on later runs, so that tests against the real API can
+// run offline and deterministically.
+//
+// A recorder is given to a client as its transport:
+//
+//	rec, err := vcr.New("testdata/songs.json", vcr.ModeRecordOnce, nil)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//	client := goacnh.New(goacnh.WithTransport(rec))
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrNoInteraction is returned by a replaying recorder for a request that its
+// cassette holds no response for.
+var ErrNoInteraction = errors.New("no recorded interaction for request")
+
+// Mode selects whether a recorder sends requests on and records them, or
+// replays responses from its cassette.
+type Mode int
+
+const (
+	// ModeRecordOnce replays the cassette if it exists, and records one
+	// otherwise.
+	ModeRecordOnce Mode = iota
+	// ModeReplay only replays the cassette, which must exist.
+	ModeReplay
+	// ModeRecord always sends requests on and replaces the cassette with what
+	// was received.
+	ModeRecord
+)
+
+// Interaction is a request and the response recorded for it.
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       []byte      `json:"body,omitempty"`
+}
+
+// cassette is the file format a recorder reads and writes.
+type cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is a round tripper that records or replays interactions with a
+// cassette file. It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	recording bool
+	next      http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	// played counts the interactions replayed for each method and URL, so
+	// that repeated requests are answered in the order they were recorded.
+	played map[string]int
+}
+
+// New creates a recorder for the cassette at the given path in the given
+// mode. Requests that are recorded are sent on through next, or through
+// http.DefaultTransport if next is nil. An error is returned if the cassette
+// must be replayed but could not be read.
+func New(path string, mode Mode, next http.RoundTripper) (*Recorder, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	r := &Recorder{path: path, next: next, played: make(map[string]int)}
+	switch mode {
+	case ModeRecord:
+		r.recording = true
+		return r, nil
+	case ModeRecordOnce, ModeReplay:
+	default:
+		return nil, fmt.Errorf("unknown vcr mode: %d", mode)
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) && mode == ModeRecordOnce {
+		r.recording = true
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	var c cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette: %w", err)
+	}
+	r.interactions = c.Interactions
+	return r, nil
+}
+
+// Recording reports whether the recorder sends requests on and records them,
+// rather than replaying its cassette.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// RoundTrip answers the request from the cassette when replaying, and sends
+// it on and records the response when recording.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !r.recording {
+		return r.replay(req)
+	}
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to record response: %w", err)
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	})
+	r.mu.Unlock()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// replay returns the next recorded response for the request's method and URL.
+// Once every response recorded for them has been returned, the last is
+// returned again.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.String()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var matches []*Interaction
+	for i := range r.interactions {
+		in := &r.interactions[i]
+		if in.Method == req.Method && in.URL == req.URL.String() {
+			matches = append(matches, in)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoInteraction, key)
+	}
+	n := r.played[key]
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+	r.played[key]++
+	in := matches[n]
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.StatusCode, http.StatusText(in.StatusCode)),
+		StatusCode:    in.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        in.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(in.Body)),
+		ContentLength: int64(len(in.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Stop writes what the recorder recorded to its cassette, creating its
+// directory if needed. It does nothing if the recorder was replaying.
+func (r *Recorder) Stop() error {
+	if !r.recording {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(cassette{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package goacnh
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrCorruptDownload matches any CorruptDownloadError via errors.Is.
+var ErrCorruptDownload = errors.New("download is corrupt")
+
+// CorruptDownloadError is returned when a downloaded file does not match what
+// the server said it would send, such as when the connection dropped part way
+// through, so that broken files are not mistaken for good ones.
+type CorruptDownloadError struct {
+	// Path is the file that was downloaded to, which has been removed, or is
+	// empty for downloads to a writer. For clients that resume downloads, a
+	// truncated download is kept as a partial file to resume from instead.
+	Path string
+	// Size is the number of bytes received, and ExpectedSize the number the
+	// server said it would send, or -1 if it did not say.
+	Size         int64
+	ExpectedSize int64
+	// ChecksumMismatch is set if the file did not match the checksum the
+	// server gave in a Content-MD5 or Digest header.
+	ChecksumMismatch bool
+	// Err is the error that interrupted the download, if any.
+	Err error
+}
+
+func (e *CorruptDownloadError) Error() string {
+	msg := "download is corrupt"
+	if e.Path != "" {
+		msg += ": " + e.Path
+	}
+	switch {
+	case e.ChecksumMismatch:
+		msg += ": checksum mismatch"
+	case e.ExpectedSize >= 0:
+		msg += fmt.Sprintf(": got %d of %d bytes", e.Size, e.ExpectedSize)
+	default:
+		msg += fmt.Sprintf(": got %d bytes", e.Size)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Is reports whether target is ErrCorruptDownload.
+func (e *CorruptDownloadError) Is(target error) bool {
+	return target == ErrCorruptDownload
+}
+
+func (e *CorruptDownloadError) Unwrap() error {
+	return e.Err
+}
+
+// truncated reports whether the download simply stopped short, so that it can
+// be resumed from what was received.
+func (e *CorruptDownloadError) truncated() bool {
+	return !e.ChecksumMismatch && (e.ExpectedSize < 0 || e.Size < e.ExpectedSize)
+}
+
+// checksum is a hash of a response body to compare with the one the server
+// gave for it.
+type checksum struct {
+	hash hash.Hash
+	want []byte
+}
+
+// responseChecksums returns the checksums the server gave for the body of a
+// response, in a Content-MD5 header or as a SHA-256 or MD5 Digest header.
+// Checksums that cannot be parsed are ignored.
+func responseChecksums(resp *resty.Response) []checksum {
+	var checksums []checksum
+	if want, err := base64.StdEncoding.DecodeString(resp.Header().Get("Content-MD5")); err == nil && len(want) == md5.Size {
+		checksums = append(checksums, checksum{hash: md5.New(), want: want})
+	}
+	for _, digest := range strings.Split(resp.Header().Get("Digest"), ",") {
+		algorithm, value, ok := strings.Cut(strings.TrimSpace(digest), "=")
+		if !ok {
+			continue
+		}
+		want, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		switch {
+		case strings.EqualFold(algorithm, "sha-256") && len(want) == sha256.Size:
+			checksums = append(checksums, checksum{hash: sha256.New(), want: want})
+		case strings.EqualFold(algorithm, "md5") && len(want) == md5.Size:
+			checksums = append(checksums, checksum{hash: md5.New(), want: want})
+		}
+	}
+	return checksums
+}
+
+// copyVerified copies body, the raw body of a response or what is left of it,
+// to w, returning a CorruptDownloadError if it could not be read in full, its
+// size differs from the response's Content-Length, or, if verifyChecksums is
+// set, it does not match a checksum the server gave for it.
+func copyVerified(w io.Writer, resp *resty.Response, body io.Reader, verifyChecksums bool) (int64, error) {
+	expected := resp.RawResponse.ContentLength
+	var checksums []checksum
+	if verifyChecksums {
+		checksums = responseChecksums(resp)
+	}
+	writers := []io.Writer{w}
+	for _, sum := range checksums {
+		writers = append(writers, sum.hash)
+	}
+	written, err := io.Copy(io.MultiWriter(writers...), body)
+	if err != nil {
+		return written, &CorruptDownloadError{Size: written, ExpectedSize: expected, Err: err}
+	}
+	if expected >= 0 && written != expected {
+		return written, &CorruptDownloadError{Size: written, ExpectedSize: expected}
+	}
+	for _, sum := range checksums {
+		if !bytes.Equal(sum.hash.Sum(nil), sum.want) {
+			return written, &CorruptDownloadError{Size: written, ExpectedSize: expected, ChecksumMismatch: true}
+		}
+	}
+	return written, nil
+}
+
+// ErrUnexpectedContent matches any UnexpectedContentError via errors.Is.
+var ErrUnexpectedContent = errors.New("unexpected download content")
+
+// UnexpectedContentError is returned when a download is not the kind of file
+// that was asked for, such as when the API answers a request for an MP3 file
+// with an HTML or JSON error page and a 200 status code. Nothing is saved.
+type UnexpectedContentError struct {
+	URL string
+	// ContentType is the Content-Type header of the response.
+	ContentType string
+	// Body is the start of the response body if it is text, which often
+	// explains the error.
+	Body string
+}
+
+func (e *UnexpectedContentError) Error() string {
+	msg := fmt.Sprintf("unexpected download content from %s", e.URL)
+	if e.ContentType != "" {
+		msg += fmt.Sprintf(" (%s)", e.ContentType)
+	}
+	if e.Body != "" {
+		msg += ": " + e.Body
+	}
+	return msg
+}
+
+// Is reports whether target is ErrUnexpectedContent.
+func (e *UnexpectedContentError) Is(target error) bool {
+	return target == ErrUnexpectedContent
+}
+
+// audioContentTypes are the media types, other than audio/*, that audio files
+// may be served with.
+var audioContentTypes = map[string]bool{
+	"application/octet-stream": true,
+	"binary/octet-stream":      true,
+}
+
+// sniffLength is how much of a download is read to check its content.
+const sniffLength int = 512
+
+// checkContent checks that the response to the request for an audio file has
+// an audio Content-Type and, if sniff is set because the body is the start of
+// the file, begins like an MP3 file. Returned is the body to read the download
+// from, or an UnexpectedContentError. Other downloads are not checked.
+func checkContent(req downloadRequest, resp *resty.Response, sniff bool) (io.Reader, error) {
+	body := bufio.NewReaderSize(resp.RawBody(), sniffLength)
+	if !req.audio {
+		return body, nil
+	}
+	contentType := resp.Header().Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	ok := contentType == "" || strings.HasPrefix(mediaType, "audio/") || audioContentTypes[mediaType]
+	head, _ := body.Peek(sniffLength)
+	if ok && sniff && len(head) > 0 {
+		ok = isMP3(head)
+	}
+	if ok {
+		return body, nil
+	}
+	contentErr := &UnexpectedContentError{ContentType: contentType, Body: bodySnippet(head)}
+	if resp.RawResponse != nil && resp.RawResponse.Request != nil {
+		contentErr.URL = resp.RawResponse.Request.URL.String()
+	}
+	return nil, contentErr
+}
+
+// isMP3 reports whether data begins with an ID3 tag or an MPEG audio frame.
+func isMP3(data []byte) bool {
+	if bytes.HasPrefix(data, []byte("ID3")) {
+		return true
+	}
+	return len(data) >= 2 && data[0] == 0xFF && data[1]&0xE0 == 0xE0
+}
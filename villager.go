@@ -0,0 +1,64 @@
+package goacnh
+
+import (
+	"context"
+	"io"
+)
+
+// VillagerImageDownload downloads the photo of the villager with the given ID
+// as a PNG file to a given directory. The file is named after the villager's
+// ID. The given download dir must exist before calling this. Returned is the
+// file path of the downloaded image, provided there was no error. Use
+// VillagerImageDownloadContext to control cancellation and deadlines.
+func (c *Client) VillagerImageDownload(id int, downloadDirectory string) (string, error) {
+	return c.VillagerImageDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// VillagerImageDownloadContext is like VillagerImageDownload but makes its
+// requests with the given context.
+func (c *Client) VillagerImageDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.ImageDownloadContext(ctx, VillagerCategory, id, downloadDirectory)
+}
+
+// VillagerImageDownloadTo writes the PNG photo of the villager with the given
+// ID to w. An error is returned if the request failed or a non 200 error code
+// was returned. Use VillagerImageDownloadToContext to control cancellation and
+// deadlines.
+func (c *Client) VillagerImageDownloadTo(id int, w io.Writer) error {
+	return c.VillagerImageDownloadToContext(context.Background(), id, w)
+}
+
+// VillagerImageDownloadToContext is like VillagerImageDownloadTo but makes its
+// requests with the given context.
+func (c *Client) VillagerImageDownloadToContext(ctx context.Context, id int, w io.Writer) error {
+	return c.ImageDownloadToContext(ctx, VillagerCategory, id, w)
+}
+
+// VillagerIconDownload downloads the icon of the villager with the given ID as
+// a PNG file to a given directory. The file is named after the villager's ID.
+// The given download dir must exist before calling this. Returned is the file
+// path of the downloaded icon, provided there was no error. Use
+// VillagerIconDownloadContext to control cancellation and deadlines.
+func (c *Client) VillagerIconDownload(id int, downloadDirectory string) (string, error) {
+	return c.VillagerIconDownloadContext(context.Background(), id, downloadDirectory)
+}
+
+// VillagerIconDownloadContext is like VillagerIconDownload but makes its
+// requests with the given context.
+func (c *Client) VillagerIconDownloadContext(ctx context.Context, id int, downloadDirectory string) (string, error) {
+	return c.IconDownloadContext(ctx, VillagerCategory, id, downloadDirectory)
+}
+
+// VillagerIconDownloadTo writes the PNG icon of the villager with the given ID
+// to w. An error is returned if the request failed or a non 200 error code was
+// returned. Use VillagerIconDownloadToContext to control cancellation and
+// deadlines.
+func (c *Client) VillagerIconDownloadTo(id int, w io.Writer) error {
+	return c.VillagerIconDownloadToContext(context.Background(), id, w)
+}
+
+// VillagerIconDownloadToContext is like VillagerIconDownloadTo but makes its
+// requests with the given context.
+func (c *Client) VillagerIconDownloadToContext(ctx context.Context, id int, w io.Writer) error {
+	return c.IconDownloadToContext(ctx, VillagerCategory, id, w)
+}
@@ -0,0 +1,52 @@
+package goacnh
+
+import "context"
+
+// WallMountedItem represents a piece of furniture that hangs on a wall, as
+// represented via the API. Like houseware, each item is provided as a list of
+// its variants, which all share the same name.
+type WallMountedItem struct {
+	Name     string
+	Variants []*ItemVariant
+}
+
+func newWallMountedItem(name string, variants []*ItemVariant) *WallMountedItem {
+	return &WallMountedItem{Name: name, Variants: variants}
+}
+
+var wallMountedResource = &resource[WallMountedItem]{
+	category:        WallMountedCategory,
+	description:     "wall-mounted item",
+	listDescription: "wall-mounted list",
+	list:            wallMountedListEndpoint,
+	stream:          variantStream(newWallMountedItem),
+	names:           func(item *WallMountedItem) []map[string]string { return variantNames(item.Variants) },
+}
+
+// WallMountedList returns all the wall-mounted items that the API provides. An
+// error is returned if the request failed or a non 200 error code was returned.
+// Use WallMountedListContext to control cancellation and deadlines.
+func (c *Client) WallMountedList() ([]*WallMountedItem, error) {
+	return c.WallMountedListContext(context.Background())
+}
+
+// WallMountedListContext is like WallMountedList but makes its requests with
+// the given context.
+func (c *Client) WallMountedListContext(ctx context.Context) ([]*WallMountedItem, error) {
+	return wallMountedResource.listAll(ctx, c)
+}
+
+// WallMountedByName get a wall-mounted item based on its name. The name is
+// compared with its names in each of the client's languages, and then in any
+// other language. An error is returned if the request failed or a non 200 error
+// code was returned or no match was found. Use WallMountedByNameContext to
+// control cancellation and deadlines.
+func (c *Client) WallMountedByName(name string) (*WallMountedItem, error) {
+	return c.WallMountedByNameContext(context.Background(), name)
+}
+
+// WallMountedByNameContext is like WallMountedByName but makes its requests
+// with the given context.
+func (c *Client) WallMountedByNameContext(ctx context.Context, name string) (*WallMountedItem, error) {
+	return wallMountedResource.byName(ctx, c, name)
+}